@@ -0,0 +1,177 @@
+package mailerlite
+
+import (
+	"context"
+	"errors"
+	"strings"
+)
+
+// ErrEmptySubscriber is returned for an empty subscriber ID or email given to
+// GroupService.AssignMany or GroupService.UnAssignMany, and for a nil record
+// given to SubscriberService.UpsertMany or SubscriberService.UpsertStream.
+var ErrEmptySubscriber = errors.New("mailerlite: empty subscriber")
+
+// MembershipResult is the outcome of the assignment of a single subscriber
+// to a group, or of its removal from it.
+type MembershipResult struct {
+	Index        int    // Index of the subscriber in the input.
+	Subscriber   string // Subscriber is the ID or email as given.
+	SubscriberID string // SubscriberID is the ID the email resolved to, empty if it could not be resolved.
+	Err          error  // Err is the error of a failed change.
+}
+
+// MembershipReport is the result of GroupService.AssignMany and
+// GroupService.UnAssignMany.
+type MembershipReport struct {
+	Results   []MembershipResult // Results in the order of the input.
+	Succeeded int
+	Failed    int
+}
+
+// Failures returns the results of the failed changes.
+func (r *MembershipReport) Failures() []MembershipResult {
+	var failures []MembershipResult
+	for _, result := range r.Results {
+		if result.Err != nil {
+			failures = append(failures, result)
+		}
+	}
+	return failures
+}
+
+// AssignMany - assigns the subscribers to the group, reporting the outcome of each one instead of stopping at
+// the first failure. Subscribers are given by ID or by email, emails being resolved with SubscriberService.Get.
+// With WithBatching, the assignments are sent through the batch endpoint. The error is only set when ctx is
+// done before all the subscribers were assigned, the report then holds the ones which were.
+func (s *groupService) AssignMany(ctx context.Context, groupID string, subscribers []string, opts ...BulkOption) (*MembershipReport, error) {
+	return s.changeMembership(ctx, groupID, subscribers, true, newBulkOptions(opts))
+}
+
+// UnAssignMany - removes the subscribers from the group, like AssignMany.
+func (s *groupService) UnAssignMany(ctx context.Context, groupID string, subscribers []string, opts ...BulkOption) (*MembershipReport, error) {
+	return s.changeMembership(ctx, groupID, subscribers, false, newBulkOptions(opts))
+}
+
+func (s *groupService) changeMembership(ctx context.Context, groupID string, subscribers []string, assign bool, o *bulkOptions) (*MembershipReport, error) {
+	results := make([]MembershipResult, len(subscribers))
+	done := make([]bool, len(subscribers))
+	for i, subscriber := range subscribers {
+		results[i] = MembershipResult{Index: i, Subscriber: subscriber}
+	}
+
+	if !o.batch {
+		forEach(ctx, len(results), o.concurrency, func(i int) {
+			result := &results[i]
+			result.SubscriberID, result.Err = s.resolveSubscriber(ctx, result.Subscriber)
+			if result.Err == nil {
+				result.Err = s.changeOne(ctx, groupID, result.SubscriberID, assign)
+			}
+			done[i] = true
+		})
+		return membershipReport(ctx, results, done)
+	}
+
+	// Emails are resolved first, so batches only hold subscribers whose ID
+	// is known.
+	resolved := make([]bool, len(results))
+	forEach(ctx, len(results), o.concurrency, func(i int) {
+		result := &results[i]
+		result.SubscriberID, result.Err = s.resolveSubscriber(ctx, result.Subscriber)
+		resolved[i] = true
+	})
+
+	var pending []int
+	for i, result := range results {
+		switch {
+		case !resolved[i]:
+		case result.Err != nil:
+			done[i] = true
+		default:
+			pending = append(pending, i)
+		}
+	}
+
+	chunks := (len(pending) + MaxBatchSize - 1) / MaxBatchSize
+	forEach(ctx, chunks, o.concurrency, func(c int) {
+		end := (c + 1) * MaxBatchSize
+		if end > len(pending) {
+			end = len(pending)
+		}
+		chunk := pending[c*MaxBatchSize : end]
+		s.changeBatch(ctx, groupID, chunk, results, assign)
+		for _, i := range chunk {
+			done[i] = true
+		}
+	})
+	return membershipReport(ctx, results, done)
+}
+
+// resolveSubscriber returns the ID of the subscriber given by ID or email.
+func (s *groupService) resolveSubscriber(ctx context.Context, subscriber string) (string, error) {
+	subscriber = strings.TrimSpace(subscriber)
+	if subscriber == "" {
+		return "", ErrEmptySubscriber
+	}
+	if !strings.Contains(subscriber, "@") {
+		return subscriber, nil
+	}
+
+	root, _, err := s.client.Subscriber.Get(ctx, &GetSubscriberOptions{Email: subscriber})
+	if err != nil {
+		return "", err
+	}
+	return root.Data.ID, nil
+}
+
+func (s *groupService) changeOne(ctx context.Context, groupID, subscriberID string, assign bool) error {
+	if assign {
+		_, _, err := s.Assign(ctx, groupID, subscriberID)
+		return err
+	}
+	_, err := s.UnAssign(ctx, groupID, subscriberID)
+	return err
+}
+
+func (s *groupService) changeBatch(ctx context.Context, groupID string, chunk []int, results []MembershipResult, assign bool) {
+	batch := NewBatch()
+	ops := make([]interface{ Err() error }, len(chunk))
+	for n, i := range chunk {
+		if assign {
+			ops[n] = batch.GroupAssign(groupID, results[i].SubscriberID)
+		} else {
+			ops[n] = batch.GroupUnAssign(groupID, results[i].SubscriberID)
+		}
+	}
+
+	_, _, sendErr := s.client.Batch.Send(ctx, batch)
+
+	for n, op := range ops {
+		err := op.Err()
+		if errors.Is(err, ErrBatchNotSent) && sendErr != nil {
+			err = sendErr
+		}
+		results[chunk[n]].Err = err
+	}
+}
+
+// membershipReport returns the report of the done changes, with ctx.Err()
+// if some were not done.
+func membershipReport(ctx context.Context, results []MembershipResult, done []bool) (*MembershipReport, error) {
+	report := &MembershipReport{Results: make([]MembershipResult, 0, len(results))}
+	for i, result := range results {
+		if !done[i] {
+			continue
+		}
+		report.Results = append(report.Results, result)
+		if result.Err != nil {
+			report.Failed++
+		} else {
+			report.Succeeded++
+		}
+	}
+
+	if len(report.Results) < len(results) {
+		return report, ctx.Err()
+	}
+	return report, nil
+}
@@ -0,0 +1,87 @@
+package mailerlite_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/mailerlite/mailerlite-go"
+	"github.com/mailerlite/mailerlite-go/mailerlitetest"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAssignMany(t *testing.T) {
+	for name, opts := range map[string][]mailerlite.BulkOption{
+		"requests": {mailerlite.WithConcurrency(8)},
+		"batches":  {mailerlite.WithBatching(), mailerlite.WithConcurrency(2)},
+	} {
+		t.Run(name, func(t *testing.T) {
+			server := mailerlitetest.NewServer()
+			defer server.Close()
+
+			client := server.Client()
+			group, _, err := client.Group.Create(context.TODO(), "Customers")
+			assert.NoError(t, err)
+
+			// Half of the subscribers are given by ID, the others by email.
+			var subscribers []string
+			for i := 0; i < 60; i++ {
+				email := fmt.Sprintf("subscriber-%d@example.com", i)
+				root, _, err := client.Subscriber.Upsert(context.TODO(), &mailerlite.UpsertSubscriber{Email: email})
+				assert.NoError(t, err)
+				if i%2 == 0 {
+					subscribers = append(subscribers, root.Data.ID)
+				} else {
+					subscribers = append(subscribers, email)
+				}
+			}
+			subscribers = append(subscribers, "missing@example.com", "", "999999")
+
+			report, err := client.Group.AssignMany(context.TODO(), group.Data.ID, subscribers, opts...)
+
+			assert.NoError(t, err)
+			assert.Equal(t, 60, report.Succeeded)
+			assert.Equal(t, 3, report.Failed)
+			assert.Len(t, report.Results, len(subscribers))
+			for i, result := range report.Results {
+				assert.Equal(t, i, result.Index)
+				assert.Equal(t, subscribers[i], result.Subscriber)
+			}
+			assert.NotEmpty(t, report.Results[1].SubscriberID)
+
+			failures := report.Failures()
+			assert.Len(t, failures, 3)
+			assert.ErrorIs(t, failures[0].Err, mailerlite.ErrNotFound)
+			assert.Empty(t, failures[0].SubscriberID)
+			assert.ErrorIs(t, failures[1].Err, mailerlite.ErrEmptySubscriber)
+			assert.ErrorIs(t, failures[2].Err, mailerlite.ErrNotFound)
+
+			members, _, err := client.Group.Subscribers(context.TODO(), &mailerlite.ListGroupSubscriberOptions{GroupID: group.Data.ID, Limit: 100})
+			assert.NoError(t, err)
+			assert.Len(t, members.Data, 60)
+
+			report, err = client.Group.UnAssignMany(context.TODO(), group.Data.ID, subscribers[:30], opts...)
+
+			assert.NoError(t, err)
+			assert.Equal(t, 30, report.Succeeded)
+			assert.Zero(t, report.Failed)
+
+			members, _, err = client.Group.Subscribers(context.TODO(), &mailerlite.ListGroupSubscriberOptions{GroupID: group.Data.ID, Limit: 100})
+			assert.NoError(t, err)
+			assert.Len(t, members.Data, 30)
+		})
+	}
+}
+
+func TestAssignManyStopsWhenContextIsDone(t *testing.T) {
+	server := mailerlitetest.NewServer()
+	defer server.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	report, err := server.Client().Group.AssignMany(ctx, "1", []string{"1", "2"})
+
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.Empty(t, report.Results)
+}
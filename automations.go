@@ -11,8 +11,10 @@ const automationEndpoint = "/automations"
 // AutomationService defines an interface for automation-related operations.
 type AutomationService interface {
 	List(ctx context.Context, options *ListAutomationOptions) (*RootAutomations, *Response, error)
+	All(ctx context.Context, options *ListAutomationOptions) *Pager[Automation]
 	Get(ctx context.Context, automationID string) (*RootAutomation, *Response, error)
 	Subscribers(ctx context.Context, options *ListAutomationSubscriberOptions) (*RootAutomationsSubscriber, *Response, error)
+	AllSubscribers(ctx context.Context, options *ListAutomationSubscriberOptions) *Pager[AutomationSubscriber]
 	Create(ctx context.Context, automationName string) (*RootAutomation, *Response, error)
 	Delete(ctx context.Context, automationID string) (*Response, error)
 }
@@ -50,7 +52,7 @@ type Automation struct {
 	EmailsCount               int             `json:"emails_count"`
 	FirstEmailScreenshotURL   interface{}     `json:"first_email_screenshot_url"`
 	Stats                     AutomationStats `json:"stats"`
-	CreatedAt                 string          `json:"created_at"`
+	CreatedAt                 Timestamp       `json:"created_at"`
 	HasBannedContent          bool            `json:"has_banned_content"`
 	QualifiedSubscribersCount int             `json:"qualified_subscribers_count"`
 }
@@ -67,11 +69,11 @@ type Step struct {
 	ParentID            string       `json:"parent_id"`
 	Unit                string       `json:"unit,omitempty"`
 	Complete            bool         `json:"complete,omitempty"`
-	CreatedAt           string       `json:"created_at"`
+	CreatedAt           Timestamp    `json:"created_at"`
 	YesStepId           string       `json:"yes_step_id,omitempty"`
 	NoStepId            string       `json:"no_step_id,omitempty"`
 	Broken              bool         `json:"broken"`
-	UpdatedAt           string       `json:"updated_at"`
+	UpdatedAt           Timestamp    `json:"updated_at"`
 	Value               interface{}  `json:"value,omitempty"`
 	MatchingType        string       `json:"matching_type,omitempty"`
 	Description         string       `json:"description"`
@@ -143,7 +145,7 @@ type AutomationStats struct {
 type AutomationSubscriber struct {
 	ID                string                   `json:"id"`
 	Status            string                   `json:"status"`
-	Date              string                   `json:"date"`
+	Date              Timestamp                `json:"date"`
 	Reason            interface{}              `json:"reason"`
 	ReasonDescription string                   `json:"reason_description"`
 	Subscriber        AutomationSubscriberMeta `json:"subscriber"`
@@ -194,6 +196,25 @@ func (s *automationService) List(ctx context.Context, options *ListAutomationOpt
 	return root, res, nil
 }
 
+// All - returns a Pager over all the automations, starting at options.Page
+func (s *automationService) All(ctx context.Context, options *ListAutomationOptions) *Pager[Automation] {
+	opts := ListAutomationOptions{}
+	if options != nil {
+		opts = *options
+	}
+
+	return newPagePager(ctx, opts.Page, func(ctx context.Context, page int) ([]Automation, *Links, *Meta, *Response, error) {
+		pageOpts := opts
+		pageOpts.Page = page
+
+		root, res, err := s.List(ctx, &pageOpts)
+		if err != nil {
+			return nil, nil, nil, res, err
+		}
+		return root.Data, &root.Links, &root.Meta, res, nil
+	})
+}
+
 func (s *automationService) Get(ctx context.Context, automationID string) (*RootAutomation, *Response, error) {
 	path := fmt.Sprintf("%s/%s", automationEndpoint, automationID)
 	req, err := s.client.newRequest(http.MethodGet, path, nil)
@@ -227,6 +248,25 @@ func (s *automationService) Subscribers(ctx context.Context, options *ListAutoma
 	return root, res, nil
 }
 
+// AllSubscribers - returns a Pager over all the subscribers activity of an automation, starting at options.Page
+func (s *automationService) AllSubscribers(ctx context.Context, options *ListAutomationSubscriberOptions) *Pager[AutomationSubscriber] {
+	opts := ListAutomationSubscriberOptions{}
+	if options != nil {
+		opts = *options
+	}
+
+	return newPagePager(ctx, opts.Page, func(ctx context.Context, page int) ([]AutomationSubscriber, *Links, *Meta, *Response, error) {
+		pageOpts := opts
+		pageOpts.Page = page
+
+		root, res, err := s.Subscribers(ctx, &pageOpts)
+		if err != nil {
+			return nil, nil, nil, res, err
+		}
+		return root.Data, &root.Links, &root.Meta, res, nil
+	})
+}
+
 func (s *automationService) Create(ctx context.Context, automationName string) (*RootAutomation, *Response, error) {
 	body := map[string]interface{}{"name": automationName}
 	req, err := s.client.newRequest(http.MethodPost, automationEndpoint, body)
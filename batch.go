@@ -0,0 +1,289 @@
+package mailerlite
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+)
+
+const (
+	batchEndpoint = "/batch"
+
+	// MaxBatchSize is the number of operations the API accepts in a single
+	// batch request, larger batches are split into several requests.
+	MaxBatchSize = 50
+)
+
+// ErrBatchNotSent is the error of the operations of a batch which were not
+// sent, because the batch was not sent yet or a previous request failed.
+var ErrBatchNotSent = errors.New("mailerlite: batch operation not sent")
+
+// BatchService defines an interface for batch-related operations.
+type BatchService interface {
+	Send(ctx context.Context, batch *Batch) (*RootBatch, *Response, error)
+}
+
+// batchService implements BatchService.
+type batchService struct {
+	*service
+}
+
+// RootBatch - batch response, summed over the requests a batch was split into
+type RootBatch struct {
+	Total      int             `json:"total"`
+	Successful int             `json:"successful"`
+	Failed     int             `json:"failed"`
+	Responses  []BatchResponse `json:"responses"`
+}
+
+// BatchRequest is a single request of a batch.
+type BatchRequest struct {
+	Method string      `json:"method"`
+	Path   string      `json:"path"`
+	Body   interface{} `json:"body,omitempty"`
+}
+
+// BatchResponse is the response to a single request of a batch.
+type BatchResponse struct {
+	Code int             `json:"code"`
+	Body json.RawMessage `json:"body"`
+}
+
+// Batch collects operations to run with BatchService.Send. Each operation
+// returns a BatchOperation holding its typed result once the batch is sent.
+//
+//	batch := mailerlite.NewBatch()
+//	upsert := batch.SubscriberUpsert(&mailerlite.UpsertSubscriber{Email: "example@example.com"})
+//	field := batch.FieldCreate("Title", "text")
+//
+//	_, _, err := client.Batch.Send(ctx, batch)
+//	...
+//	subscriber, err := upsert.Result()
+//
+// Operations rejected before sending, such as a subscriber with an invalid
+// status, are not added to the batch and keep their error.
+type Batch struct {
+	operations []batchOperation
+}
+
+type batchOperation interface {
+	request() *BatchRequest
+	resolve(req *http.Request, res BatchResponse)
+}
+
+// BatchOperation is an operation of a Batch, decoding its response into T.
+type BatchOperation[T any] struct {
+	req    BatchRequest
+	status int
+	result *T
+	err    error
+}
+
+// NewBatch returns an empty batch.
+func NewBatch() *Batch {
+	return &Batch{}
+}
+
+// Len returns the number of operations in the batch.
+func (b *Batch) Len() int {
+	return len(b.operations)
+}
+
+func addBatchOperation[T any](b *Batch, method, path string, body interface{}) *BatchOperation[T] {
+	op := &BatchOperation[T]{
+		req: BatchRequest{Method: method, Path: path, Body: body},
+		err: ErrBatchNotSent,
+	}
+	b.operations = append(b.operations, op)
+	return op
+}
+
+// rejectBatchOperation returns an operation failed with err, which is not
+// added to the batch.
+func rejectBatchOperation[T any](err error) *BatchOperation[T] {
+	return &BatchOperation[T]{err: err}
+}
+
+// SubscriberUpsert - adds SubscriberService.Upsert to the batch
+func (b *Batch) SubscriberUpsert(subscriber *UpsertSubscriber) *BatchOperation[RootSubscriber] {
+	if subscriber != nil {
+		if err := checkStatus(subscriber.Status); err != nil {
+			return rejectBatchOperation[RootSubscriber](err)
+		}
+	}
+	return addBatchOperation[RootSubscriber](b, http.MethodPost, subscriberEndpoint, subscriber)
+}
+
+// SubscriberUpdate - adds SubscriberService.Update to the batch
+func (b *Batch) SubscriberUpdate(subscriber *UpdateSubscriber) *BatchOperation[RootSubscriber] {
+	if err := checkStatus(subscriber.Status); err != nil {
+		return rejectBatchOperation[RootSubscriber](err)
+	}
+	path := fmt.Sprintf("%s/%s", subscriberEndpoint, subscriber.ID)
+	return addBatchOperation[RootSubscriber](b, http.MethodPut, path, subscriber)
+}
+
+// SubscriberDelete - adds SubscriberService.Delete to the batch
+func (b *Batch) SubscriberDelete(subscriberID string) *BatchOperation[struct{}] {
+	path := fmt.Sprintf("%s/%s", subscriberEndpoint, subscriberID)
+	return addBatchOperation[struct{}](b, http.MethodDelete, path, nil)
+}
+
+// GroupCreate - adds GroupService.Create to the batch
+func (b *Batch) GroupCreate(groupName string) *BatchOperation[RootGroup] {
+	body := map[string]interface{}{"name": groupName}
+	return addBatchOperation[RootGroup](b, http.MethodPost, groupEndpoint, body)
+}
+
+// GroupUpdate - adds GroupService.Update to the batch
+func (b *Batch) GroupUpdate(groupID, groupName string) *BatchOperation[RootGroup] {
+	body := map[string]interface{}{"name": groupName}
+	path := fmt.Sprintf("%s/%s", groupEndpoint, groupID)
+	return addBatchOperation[RootGroup](b, http.MethodPut, path, body)
+}
+
+// GroupDelete - adds GroupService.Delete to the batch
+func (b *Batch) GroupDelete(groupID string) *BatchOperation[struct{}] {
+	path := fmt.Sprintf("%s/%s", groupEndpoint, groupID)
+	return addBatchOperation[struct{}](b, http.MethodDelete, path, nil)
+}
+
+// GroupAssign - adds GroupService.Assign to the batch
+func (b *Batch) GroupAssign(groupID, subscriberID string) *BatchOperation[RootGroup] {
+	path := fmt.Sprintf("%s/%s/groups/%s", subscriberEndpoint, subscriberID, groupID)
+	return addBatchOperation[RootGroup](b, http.MethodPost, path, nil)
+}
+
+// GroupUnAssign - adds GroupService.UnAssign to the batch
+func (b *Batch) GroupUnAssign(groupID, subscriberID string) *BatchOperation[struct{}] {
+	path := fmt.Sprintf("%s/%s/groups/%s", subscriberEndpoint, subscriberID, groupID)
+	return addBatchOperation[struct{}](b, http.MethodDelete, path, nil)
+}
+
+// FieldCreate - adds FieldService.Create to the batch
+func (b *Batch) FieldCreate(fieldName, fieldType string) *BatchOperation[RootField] {
+	body := map[string]interface{}{
+		"name": fieldName,
+		"type": fieldType,
+	}
+	return addBatchOperation[RootField](b, http.MethodPost, fieldEndpoint, body)
+}
+
+// FieldUpdate - adds FieldService.Update to the batch
+func (b *Batch) FieldUpdate(fieldID, fieldName string) *BatchOperation[RootField] {
+	body := map[string]interface{}{"name": fieldName}
+	path := fmt.Sprintf("%s/%s", fieldEndpoint, fieldID)
+	return addBatchOperation[RootField](b, http.MethodPut, path, body)
+}
+
+// FieldDelete - adds FieldService.Delete to the batch
+func (b *Batch) FieldDelete(fieldID string) *BatchOperation[struct{}] {
+	path := fmt.Sprintf("%s/%s", fieldEndpoint, fieldID)
+	return addBatchOperation[struct{}](b, http.MethodDelete, path, nil)
+}
+
+// Result returns the decoded response of the operation, or the error the API
+// returned for it. The error is ErrBatchNotSent until the operation is sent.
+func (o *BatchOperation[T]) Result() (*T, error) {
+	return o.result, o.err
+}
+
+// Err returns the error of the operation, if any.
+func (o *BatchOperation[T]) Err() error {
+	return o.err
+}
+
+// StatusCode returns the status code the API returned for the operation, or
+// zero if it was not sent.
+func (o *BatchOperation[T]) StatusCode() int {
+	return o.status
+}
+
+func (o *BatchOperation[T]) request() *BatchRequest {
+	return &o.req
+}
+
+// resolve sets the result of the operation from its response, req being the
+// equivalent standalone request, used to describe errors.
+func (o *BatchOperation[T]) resolve(req *http.Request, res BatchResponse) {
+	o.status = res.Code
+	o.result, o.err = nil, nil
+
+	r := &http.Response{
+		StatusCode: res.Code,
+		Header:     make(http.Header),
+		Body:       io.NopCloser(bytes.NewReader(res.Body)),
+		Request:    req,
+	}
+	if err := checkResponse(r); err != nil {
+		o.err = err
+		return
+	}
+
+	result := new(T)
+	if len(res.Body) > 0 && string(res.Body) != "null" {
+		if err := json.Unmarshal(res.Body, result); err != nil {
+			o.err = err
+			return
+		}
+	}
+	o.result = result
+}
+
+// Send - sends the operations of the batch, in requests of up to MaxBatchSize
+// operations. It stops at the first request which fails, the operations of
+// that request and the following ones keep ErrBatchNotSent as their error.
+func (s *batchService) Send(ctx context.Context, batch *Batch) (*RootBatch, *Response, error) {
+	apiPath := strings.TrimPrefix(s.client.apiBase.Path, "/")
+
+	result := &RootBatch{Responses: []BatchResponse{}}
+	var res *Response
+
+	for start := 0; start < len(batch.operations); start += MaxBatchSize {
+		end := start + MaxBatchSize
+		if end > len(batch.operations) {
+			end = len(batch.operations)
+		}
+		chunk := batch.operations[start:end]
+
+		requests := make([]BatchRequest, len(chunk))
+		for i, op := range chunk {
+			requests[i] = *op.request()
+			requests[i].Path = apiPath + requests[i].Path
+		}
+
+		req, err := s.client.newRequest(http.MethodPost, batchEndpoint, map[string]interface{}{"requests": requests})
+		if err != nil {
+			return result, res, err
+		}
+
+		root := new(RootBatch)
+		res, err = s.client.do(ctx, req, root)
+		if err != nil {
+			return result, res, err
+		}
+		if len(root.Responses) != len(chunk) {
+			return result, res, fmt.Errorf("mailerlite: batch returned %d responses for %d requests", len(root.Responses), len(chunk))
+		}
+
+		for i, op := range chunk {
+			opReq, err := http.NewRequest(op.request().Method, fmt.Sprintf("%s%s", s.client.apiBase, op.request().Path), nil)
+			if err != nil {
+				return result, res, err
+			}
+			op.resolve(opReq, root.Responses[i])
+		}
+
+		result.Total += root.Total
+		result.Successful += root.Successful
+		result.Failed += root.Failed
+		result.Responses = append(result.Responses, root.Responses...)
+	}
+
+	return result, res, nil
+}
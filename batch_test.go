@@ -0,0 +1,146 @@
+package mailerlite_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/mailerlite/mailerlite-go"
+	"github.com/stretchr/testify/assert"
+)
+
+type batchBody struct {
+	Requests []mailerlite.BatchRequest `json:"requests"`
+}
+
+func TestBatchDemultiplexesResponses(t *testing.T) {
+	client := mailerlite.NewClient(testKey)
+
+	var sent batchBody
+	client.SetHttpClient(NewTestClient(func(req *http.Request) *http.Response {
+		assert.Equal(t, http.MethodPost, req.Method)
+		assert.Equal(t, "/api/batch", req.URL.Path)
+		_ = json.NewDecoder(req.Body).Decode(&sent)
+
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Request:    req,
+			Body: io.NopCloser(strings.NewReader(`{"total": 3, "successful": 2, "failed": 1, "responses": [
+				{"code": 201, "body": {"data": {"id": "1", "email": "example@example.com"}}},
+				{"code": 422, "body": {"message": "The name has already been taken.", "errors": {"name": ["The name has already been taken."]}}},
+				{"code": 204, "body": null}
+			]}`)),
+		}
+	}))
+
+	batch := mailerlite.NewBatch()
+	upsert := batch.SubscriberUpsert(&mailerlite.UpsertSubscriber{Email: "example@example.com"})
+	field := batch.FieldCreate("Title", "text")
+	unassign := batch.GroupUnAssign("2", "1")
+
+	_, err := upsert.Result()
+	assert.ErrorIs(t, err, mailerlite.ErrBatchNotSent)
+
+	root, _, err := client.Batch.Send(context.TODO(), batch)
+	assert.NoError(t, err)
+	assert.Equal(t, 3, root.Total)
+	assert.Equal(t, 1, root.Failed)
+
+	assert.Equal(t, []string{"POST", "POST", "DELETE"}, []string{sent.Requests[0].Method, sent.Requests[1].Method, sent.Requests[2].Method})
+	assert.Equal(t, []string{"api/subscribers", "api/fields", "api/subscribers/1/groups/2"}, []string{sent.Requests[0].Path, sent.Requests[1].Path, sent.Requests[2].Path})
+
+	subscriber, err := upsert.Result()
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusCreated, upsert.StatusCode())
+	assert.Equal(t, "example@example.com", subscriber.Data.Email)
+
+	_, err = field.Result()
+	var validationErr *mailerlite.ValidationError
+	assert.True(t, errors.As(err, &validationErr))
+	assert.Equal(t, "name", validationErr.FieldErrors()[0].Field)
+	assert.Contains(t, err.Error(), "POST https://connect.mailerlite.com/api/fields")
+
+	assert.NoError(t, unassign.Err())
+}
+
+func TestBatchSplitsIntoChunks(t *testing.T) {
+	client := mailerlite.NewClient(testKey)
+
+	var sizes []int
+	client.SetHttpClient(NewTestClient(func(req *http.Request) *http.Response {
+		var sent batchBody
+		_ = json.NewDecoder(req.Body).Decode(&sent)
+		sizes = append(sizes, len(sent.Requests))
+
+		responses := make([]string, len(sent.Requests))
+		for i := range responses {
+			responses[i] = `{"code": 200, "body": {"data": {"id": "1"}}}`
+		}
+		body := fmt.Sprintf(`{"total": %d, "successful": %[1]d, "responses": [%s]}`, len(responses), strings.Join(responses, ","))
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Request:    req,
+			Body:       io.NopCloser(strings.NewReader(body)),
+		}
+	}))
+
+	batch := mailerlite.NewBatch()
+	for i := 0; i < 2*mailerlite.MaxBatchSize+1; i++ {
+		batch.GroupAssign("1", fmt.Sprint(i))
+	}
+
+	root, _, err := client.Batch.Send(context.TODO(), batch)
+
+	assert.NoError(t, err)
+	assert.Equal(t, []int{50, 50, 1}, sizes)
+	assert.Equal(t, 101, root.Successful)
+	assert.Len(t, root.Responses, 101)
+}
+
+func TestBatchStopsOnFailedRequest(t *testing.T) {
+	client := errorClient(http.StatusInternalServerError, `{"message": "Server Error"}`)
+
+	batch := mailerlite.NewBatch()
+	op := batch.GroupDelete("1")
+
+	_, _, err := client.Batch.Send(context.TODO(), batch)
+
+	assert.ErrorIs(t, err, mailerlite.ErrServer)
+	assert.ErrorIs(t, op.Err(), mailerlite.ErrBatchNotSent)
+}
+
+func TestBatchRejectsInvalidStatus(t *testing.T) {
+	client := mailerlite.NewClient(testKey)
+
+	var sent batchBody
+	client.SetHttpClient(NewTestClient(func(req *http.Request) *http.Response {
+		_ = json.NewDecoder(req.Body).Decode(&sent)
+
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Request:    req,
+			Body: io.NopCloser(strings.NewReader(`{"total": 1, "successful": 1, "failed": 0, "responses": [
+				{"code": 204, "body": null}
+			]}`)),
+		}
+	}))
+
+	batch := mailerlite.NewBatch()
+	upsert := batch.SubscriberUpsert(&mailerlite.UpsertSubscriber{Email: "example@example.com", Status: "Active"})
+	update := batch.SubscriberUpdate(&mailerlite.UpdateSubscriber{ID: "1", Status: "subscribed"})
+	remove := batch.SubscriberDelete("2")
+	assert.Equal(t, 1, batch.Len())
+
+	_, _, err := client.Batch.Send(context.TODO(), batch)
+
+	assert.NoError(t, err)
+	assert.Len(t, sent.Requests, 1)
+	assert.ErrorIs(t, upsert.Err(), mailerlite.ErrInvalidStatus)
+	assert.ErrorIs(t, update.Err(), mailerlite.ErrInvalidStatus)
+	assert.NoError(t, remove.Err())
+}
@@ -0,0 +1,313 @@
+package mailerlite
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sort"
+)
+
+// DefaultBulkConcurrency is the number of requests bulk operations run at
+// once unless WithConcurrency is given.
+const DefaultBulkConcurrency = 4
+
+// BulkOption configures a bulk operation.
+type BulkOption func(*bulkOptions)
+
+type bulkOptions struct {
+	concurrency int
+	batch       bool
+}
+
+func newBulkOptions(opts []BulkOption) *bulkOptions {
+	o := &bulkOptions{concurrency: DefaultBulkConcurrency}
+	for _, opt := range opts {
+		opt(o)
+	}
+	if o.concurrency < 1 {
+		o.concurrency = 1
+	}
+	return o
+}
+
+// chunkSize returns how many records a worker handles per request.
+func (o *bulkOptions) chunkSize() int {
+	if o.batch {
+		return MaxBatchSize
+	}
+	return 1
+}
+
+// WithConcurrency sets the number of requests run at once, the default is
+// DefaultBulkConcurrency. Requests still go through the client, so they are
+// subject to its rate limit settings.
+func WithConcurrency(n int) BulkOption {
+	return func(o *bulkOptions) {
+		o.concurrency = n
+	}
+}
+
+// WithBatching sends the records through the batch endpoint, up to
+// MaxBatchSize records per request.
+func WithBatching() BulkOption {
+	return func(o *bulkOptions) {
+		o.batch = true
+	}
+}
+
+// forEach calls fn for each index below n, from up to concurrency goroutines,
+// and returns once they are all done. No new call starts once ctx is done.
+func forEach(ctx context.Context, n, concurrency int, fn func(i int)) {
+	indexes := make(chan int)
+	done := make(chan struct{})
+	for w := 0; w < concurrency; w++ {
+		go func() {
+			defer func() { done <- struct{}{} }()
+			for i := range indexes {
+				fn(i)
+			}
+		}()
+	}
+
+feed:
+	for i := 0; i < n && ctx.Err() == nil; i++ {
+		select {
+		case indexes <- i:
+		case <-ctx.Done():
+			break feed
+		}
+	}
+	close(indexes)
+
+	for w := 0; w < concurrency; w++ {
+		<-done
+	}
+}
+
+// UpsertStatus is the outcome of the upsert of a single record.
+type UpsertStatus string
+
+const (
+	UpsertCreated UpsertStatus = "created"
+	UpsertUpdated UpsertStatus = "updated"
+	UpsertFailed  UpsertStatus = "failed"
+)
+
+// UpsertResult is the result of the upsert of a single record.
+type UpsertResult struct {
+	Index      int               // Index of the record in the input.
+	Input      *UpsertSubscriber // Input is the record as given.
+	Status     UpsertStatus      // Status tells whether the subscriber was created, updated, or if the upsert failed.
+	Subscriber *Subscriber       // Subscriber is the subscriber returned by the API, nil on failure.
+	Err        error             // Err is the error of a failed upsert.
+}
+
+// FieldErrors returns the validation errors of a failed upsert, if the API
+// rejected the record.
+func (r UpsertResult) FieldErrors() []FieldError {
+	var validationErr *ValidationError
+	if errors.As(r.Err, &validationErr) {
+		return validationErr.FieldErrors()
+	}
+	return nil
+}
+
+// UpsertReport is the result of SubscriberService.UpsertMany.
+type UpsertReport struct {
+	Results []UpsertResult // Results in the order of the input.
+	Created int
+	Updated int
+	Failed  int
+}
+
+// Failures returns the results of the failed upserts.
+func (r *UpsertReport) Failures() []UpsertResult {
+	var failures []UpsertResult
+	for _, result := range r.Results {
+		if result.Status == UpsertFailed {
+			failures = append(failures, result)
+		}
+	}
+	return failures
+}
+
+type indexedUpsert struct {
+	index      int
+	subscriber *UpsertSubscriber
+}
+
+// UpsertMany - upserts all the subscribers, reporting the outcome of each one instead of stopping at the
+// first failure. The error is only set when ctx is done before all the subscribers were upserted, the
+// report then holds the ones which were.
+func (s *subscriberService) UpsertMany(ctx context.Context, subscribers []*UpsertSubscriber, opts ...BulkOption) (*UpsertReport, error) {
+	// All the subscribers are ready up front, so batches are always full.
+	in := make(chan *UpsertSubscriber, len(subscribers))
+	for _, subscriber := range subscribers {
+		in <- subscriber
+	}
+	close(in)
+
+	report := &UpsertReport{Results: make([]UpsertResult, 0, len(subscribers))}
+	for result := range s.UpsertStream(ctx, in, opts...) {
+		report.Results = append(report.Results, result)
+		switch result.Status {
+		case UpsertCreated:
+			report.Created++
+		case UpsertUpdated:
+			report.Updated++
+		default:
+			report.Failed++
+		}
+	}
+
+	sort.Slice(report.Results, func(i, j int) bool {
+		return report.Results[i].Index < report.Results[j].Index
+	})
+
+	if len(report.Results) < len(subscribers) {
+		return report, ctx.Err()
+	}
+	return report, nil
+}
+
+// UpsertStream - upserts the subscribers read from the channel until it is closed, sending the outcome
+// of each one on the returned channel, in no particular order. The returned channel is closed once
+// all the subscribers read were upserted, and must be drained. Reading stops when ctx is done.
+func (s *subscriberService) UpsertStream(ctx context.Context, subscribers <-chan *UpsertSubscriber, opts ...BulkOption) <-chan UpsertResult {
+	o := newBulkOptions(opts)
+
+	chunks := make(chan []indexedUpsert)
+	go func() {
+		defer close(chunks)
+		readChunks(ctx, subscribers, o.chunkSize(), chunks)
+	}()
+
+	results := make(chan UpsertResult)
+	done := make(chan struct{})
+	for i := 0; i < o.concurrency; i++ {
+		go func() {
+			defer func() { done <- struct{}{} }()
+			for chunk := range chunks {
+				if o.batch {
+					s.upsertBatch(ctx, chunk, results)
+				} else {
+					s.upsertOne(ctx, chunk[0], results)
+				}
+			}
+		}()
+	}
+
+	go func() {
+		for i := 0; i < o.concurrency; i++ {
+			<-done
+		}
+		close(results)
+	}()
+
+	return results
+}
+
+// readChunks groups the subscribers read from in into chunks of up to size
+// subscribers. A chunk is sent as soon as no more subscribers are ready, so
+// a slow producer does not hold back the ones already read.
+func readChunks(ctx context.Context, in <-chan *UpsertSubscriber, size int, chunks chan<- []indexedUpsert) {
+	index := 0
+	for {
+		var chunk []indexedUpsert
+
+		select {
+		case subscriber, ok := <-in:
+			if !ok {
+				return
+			}
+			chunk = append(chunk, indexedUpsert{index, subscriber})
+			index++
+		case <-ctx.Done():
+			return
+		}
+
+		closed := false
+	fill:
+		for len(chunk) < size {
+			select {
+			case subscriber, ok := <-in:
+				if !ok {
+					closed = true
+					break fill
+				}
+				chunk = append(chunk, indexedUpsert{index, subscriber})
+				index++
+			default:
+				break fill
+			}
+		}
+
+		select {
+		case chunks <- chunk:
+		case <-ctx.Done():
+			return
+		}
+
+		if closed {
+			return
+		}
+	}
+}
+
+func upsertResult(in indexedUpsert, status int, root *RootSubscriber, err error) UpsertResult {
+	result := UpsertResult{Index: in.index, Input: in.subscriber, Status: UpsertFailed, Err: err}
+	if err != nil {
+		return result
+	}
+
+	result.Subscriber = &root.Data
+	result.Status = UpsertUpdated
+	if status == http.StatusCreated {
+		result.Status = UpsertCreated
+	}
+	return result
+}
+
+func (s *subscriberService) upsertOne(ctx context.Context, in indexedUpsert, results chan<- UpsertResult) {
+	if in.subscriber == nil {
+		results <- upsertResult(in, 0, nil, ErrEmptySubscriber)
+		return
+	}
+
+	root, res, err := s.Upsert(ctx, in.subscriber)
+
+	status := 0
+	if res != nil {
+		status = res.StatusCode
+	}
+	results <- upsertResult(in, status, root, err)
+}
+
+func (s *subscriberService) upsertBatch(ctx context.Context, chunk []indexedUpsert, results chan<- UpsertResult) {
+	// Invalid records fail on their own rather than failing the batch, the
+	// batch rejecting the ones with an invalid status.
+	batch := NewBatch()
+	var sent []indexedUpsert
+	var ops []*BatchOperation[RootSubscriber]
+	for _, in := range chunk {
+		if in.subscriber == nil {
+			results <- upsertResult(in, 0, nil, ErrEmptySubscriber)
+			continue
+		}
+		sent = append(sent, in)
+		ops = append(ops, batch.SubscriberUpsert(in.subscriber))
+	}
+
+	var sendErr error
+	if batch.Len() > 0 {
+		_, _, sendErr = s.client.Batch.Send(ctx, batch)
+	}
+
+	for i, op := range ops {
+		root, err := op.Result()
+		if errors.Is(err, ErrBatchNotSent) && sendErr != nil {
+			err = sendErr
+		}
+		results <- upsertResult(sent[i], op.StatusCode(), root, err)
+	}
+}
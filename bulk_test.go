@@ -0,0 +1,113 @@
+package mailerlite_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/mailerlite/mailerlite-go"
+	"github.com/mailerlite/mailerlite-go/mailerlitetest"
+	"github.com/stretchr/testify/assert"
+)
+
+func bulkSubscribers() []*mailerlite.UpsertSubscriber {
+	subscribers := []*mailerlite.UpsertSubscriber{{Email: "existing@example.com"}}
+	for i := 0; i < 60; i++ {
+		subscribers = append(subscribers, &mailerlite.UpsertSubscriber{Email: fmt.Sprintf("subscriber-%d@example.com", i)})
+	}
+	return append(subscribers, &mailerlite.UpsertSubscriber{Email: "invalid"})
+}
+
+func TestUpsertMany(t *testing.T) {
+	for name, opts := range map[string][]mailerlite.BulkOption{
+		"requests": {mailerlite.WithConcurrency(8)},
+		"batches":  {mailerlite.WithBatching(), mailerlite.WithConcurrency(2)},
+	} {
+		t.Run(name, func(t *testing.T) {
+			server := mailerlitetest.NewServer()
+			defer server.Close()
+
+			client := server.Client()
+			_, _, err := client.Subscriber.Upsert(context.TODO(), &mailerlite.UpsertSubscriber{Email: "existing@example.com"})
+			assert.NoError(t, err)
+
+			subscribers := bulkSubscribers()
+			report, err := client.Subscriber.UpsertMany(context.TODO(), subscribers, opts...)
+
+			assert.NoError(t, err)
+			assert.Equal(t, 60, report.Created)
+			assert.Equal(t, 1, report.Updated)
+			assert.Equal(t, 1, report.Failed)
+			assert.Len(t, report.Results, len(subscribers))
+			assert.Len(t, server.Subscribers(), 61)
+
+			for i, result := range report.Results {
+				assert.Equal(t, i, result.Index)
+				assert.Same(t, subscribers[i], result.Input)
+			}
+			assert.Equal(t, mailerlite.UpsertUpdated, report.Results[0].Status)
+			assert.Equal(t, "existing@example.com", report.Results[0].Subscriber.Email)
+
+			failures := report.Failures()
+			assert.Len(t, failures, 1)
+			assert.Equal(t, "invalid", failures[0].Input.Email)
+			assert.ErrorIs(t, failures[0].Err, mailerlite.ErrValidation)
+			assert.Equal(t, "email", failures[0].FieldErrors()[0].Field)
+		})
+	}
+}
+
+func TestUpsertManyReportsBatchFailure(t *testing.T) {
+	server := mailerlitetest.NewServer()
+	defer server.Close()
+
+	server.InjectFailure(mailerlitetest.Failure{Path: "/batch", Status: http.StatusBadRequest})
+	client := server.Client()
+
+	report, err := client.Subscriber.UpsertMany(context.TODO(), bulkSubscribers(), mailerlite.WithBatching(), mailerlite.WithConcurrency(1))
+
+	assert.NoError(t, err)
+	assert.Equal(t, 51, report.Failed)
+	assert.Equal(t, 11, report.Created)
+	assert.Equal(t, http.StatusBadRequest, report.Results[0].Err.(*mailerlite.ErrorResponse).Response.StatusCode)
+}
+
+func TestUpsertStream(t *testing.T) {
+	server := mailerlitetest.NewServer()
+	defer server.Close()
+
+	client := server.Client()
+
+	in := make(chan *mailerlite.UpsertSubscriber)
+	go func() {
+		defer close(in)
+		for i := 0; i < 10; i++ {
+			in <- &mailerlite.UpsertSubscriber{Email: fmt.Sprintf("subscriber-%d@example.com", i)}
+		}
+	}()
+
+	indexes := make(map[int]bool)
+	for result := range client.Subscriber.UpsertStream(context.TODO(), in) {
+		assert.NoError(t, result.Err)
+		assert.Equal(t, mailerlite.UpsertCreated, result.Status)
+		indexes[result.Index] = true
+	}
+
+	assert.Len(t, indexes, 10)
+}
+
+func TestUpsertManyStopsWhenContextIsDone(t *testing.T) {
+	server := mailerlitetest.NewServer()
+	defer server.Close()
+
+	client := server.Client()
+
+	ctx, cancel := context.WithCancel(context.TODO())
+	cancel()
+
+	report, err := client.Subscriber.UpsertMany(ctx, bulkSubscribers())
+
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.Less(t, len(report.Results), len(bulkSubscribers()))
+}
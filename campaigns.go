@@ -11,12 +11,14 @@ const campaignEndpoint = "/campaigns"
 // CampaignService defines an interface for campaign-related operations.
 type CampaignService interface {
 	List(ctx context.Context, options *ListCampaignOptions) (*RootCampaigns, *Response, error)
+	All(ctx context.Context, options *ListCampaignOptions) *Pager[Campaign]
 	Get(ctx context.Context, campaignID string) (*RootCampaign, *Response, error)
 	Create(ctx context.Context, campaign *CreateCampaign) (*RootCampaign, *Response, error)
 	Update(ctx context.Context, campaignID string, campaign *UpdateCampaign) (*RootCampaign, *Response, error)
 	Schedule(ctx context.Context, campaignID string, campaign *ScheduleCampaign) (*RootCampaign, *Response, error)
 	Cancel(ctx context.Context, campaignID string) (*RootCampaign, *Response, error)
 	Subscribers(ctx context.Context, options *ListCampaignSubscriberOptions) (*RootCampaignSubscribers, *Response, error)
+	AllSubscribers(ctx context.Context, options *ListCampaignSubscriberOptions) *Pager[CampaignSubscriber]
 	Languages(ctx context.Context) (*RootCampaignLanguages, *Response, error)
 	Delete(ctx context.Context, campaignID string) (*Response, error)
 }
@@ -60,13 +62,13 @@ type Campaign struct {
 	FilterForHumans            [][]string         `json:"filter_for_humans"`
 	DeliverySchedule           string             `json:"delivery_schedule"`
 	LanguageID                 string             `json:"language_id"`
-	CreatedAt                  string             `json:"created_at"`
-	UpdatedAt                  string             `json:"updated_at"`
-	ScheduledFor               string             `json:"scheduled_for"`
-	QueuedAt                   string             `json:"queued_at"`
-	StartedAt                  string             `json:"started_at"`
-	FinishedAt                 string             `json:"finished_at"`
-	StoppedAt                  interface{}        `json:"stopped_at"`
+	CreatedAt                  Timestamp          `json:"created_at"`
+	UpdatedAt                  Timestamp          `json:"updated_at"`
+	ScheduledFor               Timestamp          `json:"scheduled_for"`
+	QueuedAt                   Timestamp          `json:"queued_at"`
+	StartedAt                  Timestamp          `json:"started_at"`
+	FinishedAt                 Timestamp          `json:"finished_at"`
+	StoppedAt                  Timestamp          `json:"stopped_at"`
 	DefaultEmailID             string             `json:"default_email_id"`
 	Emails                     []Email            `json:"emails"`
 	UsedInAutomations          bool               `json:"used_in_automations"`
@@ -76,12 +78,12 @@ type Campaign struct {
 	HasWinner                  interface{}        `json:"has_winner"`
 	WinnerVersionForHuman      interface{}        `json:"winner_version_for_human"`
 	WinnerSendingTimeForHumans interface{}        `json:"winner_sending_time_for_humans"`
-	WinnerSelectedManuallyAt   interface{}        `json:"winner_selected_manually_at"`
+	WinnerSelectedManuallyAt   Timestamp          `json:"winner_selected_manually_at"`
 	UsesEcommerce              bool               `json:"uses_ecommerce"`
 	UsesSurvey                 bool               `json:"uses_survey"`
 	CanBeScheduled             bool               `json:"can_be_scheduled"`
 	Warnings                   []interface{}      `json:"warnings"`
-	InitialCreatedAt           interface{}        `json:"initial_created_at"`
+	InitialCreatedAt           Timestamp          `json:"initial_created_at"`
 	IsCurrentlySendingOut      bool               `json:"is_currently_sending_out"`
 }
 
@@ -109,8 +111,8 @@ type Email struct {
 	PlainText     string      `json:"plain_text"`
 	ScreenshotURL string      `json:"screenshot_url"`
 	PreviewURL    string      `json:"preview_url"`
-	CreatedAt     string      `json:"created_at"`
-	UpdatedAt     string      `json:"updated_at"`
+	CreatedAt     Timestamp   `json:"created_at"`
+	UpdatedAt     Timestamp   `json:"updated_at"`
 	IsDesigned    bool        `json:"is_designed"`
 	LanguageID    float64     `json:"language_id"`
 	IsWinner      bool        `json:"is_winner"`
@@ -255,6 +257,25 @@ func (s *campaignService) List(ctx context.Context, options *ListCampaignOptions
 	return root, res, nil
 }
 
+// All - returns a Pager over all the campaigns, starting at options.Page
+func (s *campaignService) All(ctx context.Context, options *ListCampaignOptions) *Pager[Campaign] {
+	opts := ListCampaignOptions{}
+	if options != nil {
+		opts = *options
+	}
+
+	return newPagePager(ctx, opts.Page, func(ctx context.Context, page int) ([]Campaign, *Links, *Meta, *Response, error) {
+		pageOpts := opts
+		pageOpts.Page = page
+
+		root, res, err := s.List(ctx, &pageOpts)
+		if err != nil {
+			return nil, nil, nil, res, err
+		}
+		return root.Data, &root.Links, &root.Meta, res, nil
+	})
+}
+
 // Get - get a single campaign ID
 func (s *campaignService) Get(ctx context.Context, campaignID string) (*RootCampaign, *Response, error) {
 	path := fmt.Sprintf("%s/%s", campaignEndpoint, campaignID)
@@ -354,6 +375,25 @@ func (s *campaignService) Subscribers(ctx context.Context, options *ListCampaign
 	return root, res, nil
 }
 
+// AllSubscribers - returns a Pager over all the subscribers activity of a campaign, starting at options.Page
+func (s *campaignService) AllSubscribers(ctx context.Context, options *ListCampaignSubscriberOptions) *Pager[CampaignSubscriber] {
+	opts := ListCampaignSubscriberOptions{}
+	if options != nil {
+		opts = *options
+	}
+
+	return newPagePager(ctx, opts.Page, func(ctx context.Context, page int) ([]CampaignSubscriber, *Links, *Meta, *Response, error) {
+		pageOpts := opts
+		pageOpts.Page = page
+
+		root, res, err := s.Subscribers(ctx, &pageOpts)
+		if err != nil {
+			return nil, nil, nil, res, err
+		}
+		return root.Data, &root.Links, &root.Meta, res, nil
+	})
+}
+
 func (s *campaignService) Languages(ctx context.Context) (*RootCampaignLanguages, *Response, error) {
 	path := fmt.Sprintf("%s/languages", campaignEndpoint)
 	req, err := s.client.newRequest(http.MethodGet, path, nil)
@@ -28,6 +28,7 @@ const (
 	HeaderRateLimit      = "X-RateLimit-Limit"
 	HeaderRateRemaining  = "X-RateLimit-Remaining"
 	HeaderRateRetryAfter = "Retry-After"
+	HeaderRateReset      = "X-RateLimit-Reset"
 )
 
 // Client - base api client
@@ -40,8 +41,18 @@ type Client struct {
 
 	userAgent string // userAgent User agent used when communicating with the API.
 
-	rateMu     sync.Mutex // rateMu protects the rate during getting rate limits from client
-	rateLimits Rate       // Rate limits for the client as determined by the most recent API calls.
+	timeout   time.Duration // timeout set by WithTimeout, applied once all options are set.
+	optionErr error         // optionErr an invalid option, returned by every request.
+
+	rateMu     sync.Mutex   // rateMu protects the rate during getting rate limits from client
+	rateLimits Rate         // Rate limits for the client as determined by the most recent API calls.
+	limiter    *tokenBucket // limiter paces requests client side, nil when disabled.
+
+	middleware []Middleware // middleware wrapped around every API call.
+
+	retryPolicy *RetryPolicy // retryPolicy used for requests that failed with a transient error.
+
+	rateLimitWait bool // rateLimitWait makes requests wait for the rate limit to reset instead of failing.
 
 	common service // common service
 
@@ -54,6 +65,7 @@ type Client struct {
 	Campaign   CampaignService   // Campaign service
 	Automation AutomationService // Automation service
 	Timezone   TimezoneService   // Timezone service
+	Batch      BatchService      // Batch service
 
 }
 
@@ -68,6 +80,9 @@ type Response struct {
 	// Explicitly specify the Rate type so Rate's String() receiver doesn't
 	// propagate to Response.
 	Rate Rate
+
+	// Attempts is the number of times the request was sent, including retries.
+	Attempts int
 }
 
 // ErrorResponse is a MailerLite API error response. This wraps the standard http.Response
@@ -87,6 +102,18 @@ type Rate struct {
 
 	// Retry After
 	RetryAfter *time.Duration `json:"retry"`
+
+	// The time at which the current rate limit window resets, zero when unknown.
+	Reset time.Time `json:"reset"`
+}
+
+// retryAfter returns RetryAfter for error messages, or Reset when the API
+// only sent the X-RateLimit-Reset header.
+func (r Rate) retryAfter() interface{} {
+	if r.RetryAfter == nil {
+		return r.Reset
+	}
+	return *r.RetryAfter
 }
 
 func (r *ErrorResponse) Error() string {
@@ -95,20 +122,71 @@ func (r *ErrorResponse) Error() string {
 		r.Response.StatusCode, r.Message, r.Errors)
 }
 
+// Unwrap returns the sentinel error matching the status code of the
+// response, e.g. ErrNotFound, so that errors.Is can be used.
+func (r *ErrorResponse) Unwrap() error {
+	if r.Response == nil {
+		return nil
+	}
+	return sentinelForStatus(r.Response.StatusCode)
+}
+
+// As makes errors.As find the *NotFoundError, *ValidationError or
+// *ServerError view of the error matching the status code of the response.
+func (r *ErrorResponse) As(target interface{}) bool {
+	if r.Response == nil {
+		return false
+	}
+
+	code := r.Response.StatusCode
+	switch target := target.(type) {
+	case **NotFoundError:
+		if code == http.StatusNotFound {
+			*target = (*NotFoundError)(r)
+			return true
+		}
+	case **ValidationError:
+		if code == http.StatusUnprocessableEntity {
+			*target = (*ValidationError)(r)
+			return true
+		}
+	case **ServerError:
+		if code >= http.StatusInternalServerError {
+			*target = (*ServerError)(r)
+			return true
+		}
+	}
+	return false
+}
+
 // AuthError occurs when using HTTP Authentication fails
 type AuthError ErrorResponse
 
 func (r *AuthError) Error() string { return (*ErrorResponse)(r).Error() }
 
-// NewClient - creates a new client instance.
-func NewClient(apiKey string) *Client {
+// Unwrap returns the underlying ErrorResponse.
+func (r *AuthError) Unwrap() error { return (*ErrorResponse)(r) }
+
+// NewClient - creates a new client instance, configured by the given options.
+func NewClient(apiKey string, opts ...ClientOption) *Client {
 	baseURL, _ := url.Parse(defaultBaseURL)
 
 	client := &Client{
-		apiBase:   baseURL,
-		apiKey:    apiKey,
-		userAgent: defaultUserAgent,
-		client:    http.DefaultClient,
+		apiBase:    baseURL,
+		apiVersion: APIVersion,
+		apiKey:     apiKey,
+		userAgent:  defaultUserAgent,
+		client:     http.DefaultClient,
+	}
+
+	for _, opt := range opts {
+		opt(client)
+	}
+
+	if client.timeout > 0 {
+		httpClient := *client.client
+		httpClient.Timeout = client.timeout
+		client.client = &httpClient
 	}
 
 	client.common.client = client
@@ -121,6 +199,7 @@ func NewClient(apiKey string) *Client {
 	client.Campaign = &campaignService{&client.common}
 	client.Automation = &automationService{&client.common}
 	client.Timezone = &timezoneService{&client.common}
+	client.Batch = &batchService{&client.common}
 
 	return client
 }
@@ -140,12 +219,56 @@ func (c *Client) SetHttpClient(client *http.Client) {
 	c.client = client
 }
 
+// BaseURL - Get the base URL requests are sent to
+func (c *Client) BaseURL() *url.URL {
+	return c.apiBase
+}
+
+// SetBaseURL - Set the base URL requests are sent to
+func (c *Client) SetBaseURL(baseURL string) error {
+	u, err := parseBaseURL(baseURL)
+	if err != nil {
+		return err
+	}
+	c.apiBase = u
+	return nil
+}
+
 // SetAPIKey - Set the client api key
 func (c *Client) SetAPIKey(apikey string) {
 	c.apiKey = apikey
 }
 
+// SetRateLimitWait - Set whether requests wait for an exceeded rate limit to reset instead of failing
+func (c *Client) SetRateLimitWait(wait bool) {
+	c.rateLimitWait = wait
+}
+
+// SetRateLimiter - Set whether requests are paced client side to stay within the last observed rate limit
+func (c *Client) SetRateLimiter(enabled bool) {
+	c.rateMu.Lock()
+	defer c.rateMu.Unlock()
+
+	if !enabled {
+		c.limiter = nil
+		return
+	}
+	if c.limiter == nil {
+		c.limiter = &tokenBucket{}
+		c.limiter.observe(c.rateLimits, time.Now())
+	}
+}
+
+// SetRetryPolicy - Set the policy used to retry failed requests, nil disables retries
+func (c *Client) SetRetryPolicy(policy *RetryPolicy) {
+	c.retryPolicy = policy
+}
+
 func (c *Client) newRequest(method, path string, body interface{}) (*http.Request, error) {
+	if c.optionErr != nil {
+		return nil, c.optionErr
+	}
+
 	reqURL := fmt.Sprintf("%s%s", c.apiBase, path)
 	reqBodyBytes := new(bytes.Buffer)
 
@@ -169,7 +292,11 @@ func (c *Client) newRequest(method, path string, body interface{}) (*http.Reques
 		req.Header.Set("User-Agent", c.userAgent)
 	}
 
-	req.Header.Set(HeaderAPIVersion, APIVersion)
+	apiVersion := c.apiVersion
+	if apiVersion == "" {
+		apiVersion = APIVersion
+	}
+	req.Header.Set(HeaderAPIVersion, apiVersion)
 
 	req.Header.Set("Content-Type", "application/json")
 	req.Header.Set("Authorization", "Bearer "+c.apiKey)
@@ -179,46 +306,128 @@ func (c *Client) newRequest(method, path string, body interface{}) (*http.Reques
 }
 
 func (c *Client) do(ctx context.Context, req *http.Request, v interface{}) (*Response, error) {
-	req = req.WithContext(ctx)
+	req = req.WithContext(withOperation(ctx, callerOperation(1)))
 
-	// If we've hit rate limit, don't make further requests before Reset time.
-	if err := c.checkRateLimitBeforeDo(req); err != nil {
-		return &Response{
-			Response: err.Response,
-			Rate:     err.Rate,
-		}, err
+	response, err := c.doer().Do(req)
+	if err != nil {
+		return response, err
 	}
 
-	resp, err := c.client.Do(req)
-	if err != nil {
-		select {
-		case <-ctx.Done():
-			return nil, ctx.Err()
-		default:
+	if v != nil {
+		err = json.NewDecoder(response.Body).Decode(v)
+		if err != nil {
+			return nil, err
 		}
-		return nil, err
 	}
 
-	response := newResponse(resp)
+	return response, nil
+}
 
-	c.rateMu.Lock()
-	c.rateLimits = response.Rate
-	c.rateMu.Unlock()
+// send sends req to the API, waiting for rate limits and retrying transient
+// failures as configured on the client.
+func (c *Client) send(req *http.Request) (*Response, error) {
+	ctx := req.Context()
 
-	err = checkResponse(resp)
-	if err != nil {
-		defer resp.Body.Close()
-		return response, err
+	// If we've hit rate limit, don't make further requests before Reset time.
+	if err := c.checkRateLimitBeforeDo(req); err != nil {
+		if !c.rateLimitWait {
+			return &Response{
+				Response: err.Response,
+				Rate:     err.Rate,
+			}, err
+		}
+		if err := c.waitForRateLimit(ctx, req, err.Rate); err != nil {
+			return nil, err
+		}
 	}
 
-	if v != nil {
-		err = json.NewDecoder(resp.Body).Decode(v)
+	attempts := c.retryPolicy.attempts(req)
+	rateLimitWaits := 0
+
+	for attempt := 1; ; {
+		if err := c.waitForToken(ctx); err != nil {
+			return nil, err
+		}
+
+		resp, err := c.client.Do(req)
 		if err != nil {
+			select {
+			case <-ctx.Done():
+				return nil, ctx.Err()
+			default:
+			}
+			if attempt < attempts {
+				if err := c.waitForRetry(ctx, req, attempt, Rate{}); err != nil {
+					return nil, err
+				}
+				attempt++
+				continue
+			}
 			return nil, err
 		}
+
+		response := newResponse(resp)
+		response.Attempts = attempt
+
+		c.rateMu.Lock()
+		c.rateLimits = response.Rate
+		if c.limiter != nil {
+			c.limiter.observe(response.Rate, time.Now())
+		}
+		c.rateMu.Unlock()
+
+		err = checkResponse(resp)
+		if err != nil {
+			resp.Body.Close()
+			// A rate limited request was not processed, so it is always safe to
+			// send it again once the window reopens.
+			if rateErr, ok := err.(*RateLimitError); ok && c.rateLimitWait && !rateErr.Rate.Reset.IsZero() && rateLimitWaits < maxRateLimitWaits {
+				if err := c.waitForRateLimit(ctx, req, rateErr.Rate); err != nil {
+					return response, err
+				}
+				rateLimitWaits++
+				continue
+			}
+			if attempt < attempts && c.retryPolicy.retryableStatus(resp.StatusCode) {
+				if err := c.waitForRetry(ctx, req, attempt, response.Rate); err != nil {
+					return response, err
+				}
+				attempt++
+				continue
+			}
+			return response, err
+		}
+
+		return response, nil
 	}
+}
+
+// maxRateLimitWaits is the number of times a request waits for the rate
+// limit to reset before its RateLimitError is returned.
+const maxRateLimitWaits = 5
+
+// minRateLimitWait keeps a server answering "Retry-After: 0" from being
+// hammered.
+const minRateLimitWait = 250 * time.Millisecond
+
+// waitForRateLimit sleeps until the rate limit window reopens, at least minRateLimitWait, and rewinds the body of req.
+func (c *Client) waitForRateLimit(ctx context.Context, req *http.Request, rate Rate) error {
+	wait := time.Until(rate.Reset)
+	if wait < minRateLimitWait {
+		wait = minRateLimitWait
+	}
+	if err := sleep(ctx, wait); err != nil {
+		return err
+	}
+	return rewindBody(req)
+}
 
-	return response, err
+// waitForRetry sleeps before the next attempt of req and rewinds its body.
+func (c *Client) waitForRetry(ctx context.Context, req *http.Request, attempt int, rate Rate) error {
+	if err := sleep(ctx, c.retryPolicy.delay(attempt, rate)); err != nil {
+		return err
+	}
+	return rewindBody(req)
 }
 
 // checkRateLimitBeforeDo does not make any network calls, but uses existing knowledge from
@@ -229,7 +438,7 @@ func (c *Client) checkRateLimitBeforeDo(req *http.Request) *RateLimitError {
 	c.rateMu.Lock()
 	rate := c.rateLimits
 	c.rateMu.Unlock()
-	if rate.Remaining == 0 && rate.RetryAfter != nil && time.Now().Before(time.Now().Add(*rate.RetryAfter)) {
+	if rate.Remaining == 0 && time.Now().Before(rate.Reset) {
 		// Create a fake response.
 		resp := &http.Response{
 			Status:     http.StatusText(http.StatusForbidden),
@@ -241,7 +450,7 @@ func (c *Client) checkRateLimitBeforeDo(req *http.Request) *RateLimitError {
 		return &RateLimitError{
 			Rate:     rate,
 			Response: resp,
-			Message:  fmt.Sprintf("API rate limit of %v still exceeded until %v, not making remote request.", rate.Limit, rate.RetryAfter),
+			Message:  fmt.Sprintf("API rate limit of %v still exceeded until %v, not making remote request.", rate.Limit, rate.retryAfter()),
 		}
 	}
 
@@ -284,6 +493,8 @@ func checkResponse(r *http.Response) error {
 			Message:  errorResponse.Message,
 		}
 	default:
+		// NotFoundError, ValidationError and ServerError are only views of
+		// the ErrorResponse, see ErrorResponse.As.
 		return errorResponse
 	}
 }
@@ -349,6 +560,15 @@ func parseRate(r *http.Response) Rate {
 		retryAfterSeconds, _ := strconv.ParseInt(retry, 10, 64) // Error handling is noop.
 		retryAfter := time.Duration(retryAfterSeconds) * time.Second
 		rate.RetryAfter = &retryAfter
+		rate.Reset = time.Now().Add(retryAfter)
+	}
+
+	if reset := r.Header.Get(HeaderRateReset); reset != "" && rate.Reset.IsZero() {
+		// The "X-RateLimit-Reset" header value is the unix timestamp at which
+		// the current rate limit window resets.
+		if resetUnix, err := strconv.ParseInt(reset, 10, 64); err == nil {
+			rate.Reset = time.Unix(resetUnix, 0)
+		}
 	}
 	return rate
 }
@@ -364,9 +584,12 @@ type RateLimitError struct {
 func (r *RateLimitError) Error() string {
 	return fmt.Sprintf("%v %v: %d %v [retry after %v]",
 		r.Response.Request.Method, r.Response.Request.URL,
-		r.Response.StatusCode, r.Message, r.Rate.RetryAfter)
+		r.Response.StatusCode, r.Message, r.Rate.retryAfter())
 }
 
+// Unwrap returns ErrRateLimited.
+func (r *RateLimitError) Unwrap() error { return ErrRateLimited }
+
 // Bool is a helper routine that allocates a new bool value
 // to store v and returns a pointer to it.
 func Bool(v bool) *bool { return &v }
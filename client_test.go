@@ -234,7 +234,7 @@ func TestWillHandleAPIFilters(t *testing.T) {
 	subscribers, _, _ := client.Subscriber.List(ctx, listOptions)
 
 	assert.Equal(t, len(subscribers.Data), 1)
-	assert.Equal(t, subscribers.Data[0].Status, "active")
+	assert.Equal(t, subscribers.Data[0].Status, mailerlite.SubscriberStatusActive)
 }
 
 func TestWillHandleMultipleAPIFilters(t *testing.T) {
@@ -274,7 +274,7 @@ func TestWillHandleMultipleAPIFilters(t *testing.T) {
 	subscribers, _, _ := client.Subscriber.List(ctx, listOptions)
 
 	assert.Equal(t, len(subscribers.Data), 1)
-	assert.Equal(t, subscribers.Data[0].Status, "active")
+	assert.Equal(t, subscribers.Data[0].Status, mailerlite.SubscriberStatusActive)
 }
 
 func TestWillHandleAPIAuthError(t *testing.T) {
@@ -393,3 +393,54 @@ func TestWillHandleAPIRateErrorAndNoRemoteCall(t *testing.T) {
 	assert.Equal(t, "GET https://connect.mailerlite.com/api/subscribers: 403 API rate limit of 120 still exceeded until 59s, not making remote request. [retry after 59s]", err.Error())
 
 }
+
+func TestNewClientWithOptions(t *testing.T) {
+	testClient := NewTestClient(func(req *http.Request) *http.Response {
+		assert.Equal(t, "http://localhost:8080/api/timezones", req.URL.String())
+		assert.Equal(t, "my-app/1.0", req.Header.Get("User-Agent"))
+		assert.Equal(t, "2024-01-01", req.Header.Get(mailerlite.HeaderAPIVersion))
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Request:    req,
+			Body:       io.NopCloser(strings.NewReader(`{"data": []}`)),
+		}
+	})
+
+	client := mailerlite.NewClient(testKey,
+		mailerlite.WithBaseURL("http://localhost:8080/api/"),
+		mailerlite.WithUserAgent("my-app/1.0"),
+		mailerlite.WithAPIVersion("2024-01-01"),
+		mailerlite.WithHTTPClient(testClient),
+	)
+
+	assert.Equal(t, testClient, client.Client())
+	assert.Equal(t, "http://localhost:8080/api", client.BaseURL().String())
+
+	_, res, err := client.Timezone.List(context.TODO())
+
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, res.StatusCode)
+}
+
+func TestNewClientWithTimeout(t *testing.T) {
+	client := mailerlite.NewClient(testKey, mailerlite.WithTimeout(5*time.Second))
+
+	assert.Equal(t, 5*time.Second, client.Client().Timeout)
+	assert.NotSame(t, http.DefaultClient, client.Client())
+	assert.Equal(t, time.Duration(0), http.DefaultClient.Timeout)
+}
+
+func TestNewClientWithNilHTTPClient(t *testing.T) {
+	client := mailerlite.NewClient(testKey, mailerlite.WithHTTPClient(nil), mailerlite.WithTimeout(5*time.Second))
+
+	assert.NotNil(t, client.Client())
+	assert.Equal(t, 5*time.Second, client.Client().Timeout)
+}
+
+func TestNewClientWithInvalidBaseURL(t *testing.T) {
+	client := mailerlite.NewClient(testKey, mailerlite.WithBaseURL("localhost"))
+
+	_, _, err := client.Timezone.List(context.TODO())
+
+	assert.Error(t, err)
+}
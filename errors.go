@@ -0,0 +1,85 @@
+package mailerlite
+
+import (
+	"errors"
+	"net/http"
+	"sort"
+	"strings"
+)
+
+// Sentinel errors matching the API errors with errors.Is, e.g.
+// errors.Is(err, mailerlite.ErrNotFound).
+var (
+	ErrUnauthorized = errors.New("mailerlite: unauthorized")
+	ErrNotFound     = errors.New("mailerlite: not found")
+	ErrValidation   = errors.New("mailerlite: validation failed")
+	ErrRateLimited  = errors.New("mailerlite: rate limited")
+	ErrServer       = errors.New("mailerlite: server error")
+)
+
+// sentinelForStatus returns the sentinel error of an HTTP status code, or
+// nil if there is none.
+func sentinelForStatus(code int) error {
+	switch {
+	case code == http.StatusUnauthorized:
+		return ErrUnauthorized
+	case code == http.StatusNotFound:
+		return ErrNotFound
+	case code == http.StatusUnprocessableEntity:
+		return ErrValidation
+	case code == http.StatusTooManyRequests:
+		return ErrRateLimited
+	case code >= http.StatusInternalServerError:
+		return ErrServer
+	default:
+		return nil
+	}
+}
+
+// NotFoundError occurs when the requested resource does not exist
+type NotFoundError ErrorResponse
+
+func (r *NotFoundError) Error() string { return (*ErrorResponse)(r).Error() }
+
+// Unwrap returns the underlying ErrorResponse.
+func (r *NotFoundError) Unwrap() error { return (*ErrorResponse)(r) }
+
+// ServerError occurs when MailerLite fails with a 5xx response
+type ServerError ErrorResponse
+
+func (r *ServerError) Error() string { return (*ErrorResponse)(r).Error() }
+
+// Unwrap returns the underlying ErrorResponse.
+func (r *ServerError) Unwrap() error { return (*ErrorResponse)(r) }
+
+// ValidationError occurs when MailerLite rejects the request data with a 422 response
+type ValidationError ErrorResponse
+
+func (r *ValidationError) Error() string { return (*ErrorResponse)(r).Error() }
+
+// Unwrap returns the underlying ErrorResponse.
+func (r *ValidationError) Unwrap() error { return (*ErrorResponse)(r) }
+
+// FieldErrors returns the validation failures per field, sorted by field name.
+func (r *ValidationError) FieldErrors() []FieldError {
+	fieldErrors := make([]FieldError, 0, len(r.Errors))
+	for field, messages := range r.Errors {
+		fieldErrors = append(fieldErrors, FieldError{Field: field, Messages: messages})
+	}
+
+	sort.Slice(fieldErrors, func(i, j int) bool {
+		return fieldErrors[i].Field < fieldErrors[j].Field
+	})
+
+	return fieldErrors
+}
+
+// FieldError is a validation failure of a single request field
+type FieldError struct {
+	Field    string   // Field the name of the field, e.g. "email" or "fields.phone".
+	Messages []string // Messages describing why the value was rejected.
+}
+
+func (e FieldError) Error() string {
+	return e.Field + ": " + strings.Join(e.Messages, " ")
+}
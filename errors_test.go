@@ -0,0 +1,105 @@
+package mailerlite_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/mailerlite/mailerlite-go"
+	"github.com/stretchr/testify/assert"
+)
+
+func errorClient(status int, body string) *mailerlite.Client {
+	testClient := NewTestClient(func(req *http.Request) *http.Response {
+		return &http.Response{
+			StatusCode: status,
+			Request:    req,
+			Body:       io.NopCloser(strings.NewReader(body)),
+		}
+	})
+
+	return mailerlite.NewClient(testKey, mailerlite.WithHTTPClient(testClient))
+}
+
+func TestNotFoundError(t *testing.T) {
+	client := errorClient(http.StatusNotFound, `{"message": "Resource not found."}`)
+
+	_, _, err := client.Subscriber.Get(context.TODO(), &mailerlite.GetSubscriberOptions{SubscriberID: "1"})
+
+	var notFound *mailerlite.NotFoundError
+	assert.True(t, errors.As(err, &notFound))
+	assert.Equal(t, "Resource not found.", notFound.Message)
+	assert.True(t, errors.Is(err, mailerlite.ErrNotFound))
+	assert.False(t, errors.Is(err, mailerlite.ErrUnauthorized))
+
+	var errorResponse *mailerlite.ErrorResponse
+	assert.True(t, errors.As(err, &errorResponse))
+	assert.Equal(t, http.StatusNotFound, errorResponse.Response.StatusCode)
+}
+
+func TestValidationError(t *testing.T) {
+	client := errorClient(http.StatusUnprocessableEntity, `{"message": "The given data was invalid.",
+		"errors": {"email": ["The email must be a valid email address."], "fields.phone": ["The phone must be a string."]}}`)
+
+	_, _, err := client.Subscriber.Upsert(context.TODO(), &mailerlite.UpsertSubscriber{Email: "invalid"})
+
+	var validation *mailerlite.ValidationError
+	assert.True(t, errors.As(err, &validation))
+	assert.True(t, errors.Is(err, mailerlite.ErrValidation))
+
+	fieldErrors := validation.FieldErrors()
+	assert.Equal(t, 2, len(fieldErrors))
+	assert.Equal(t, "email", fieldErrors[0].Field)
+	assert.Equal(t, []string{"The email must be a valid email address."}, fieldErrors[0].Messages)
+	assert.Equal(t, "fields.phone: The phone must be a string.", fieldErrors[1].Error())
+}
+
+func TestServerError(t *testing.T) {
+	client := errorClient(http.StatusServiceUnavailable, `{"message": "Service Unavailable"}`)
+
+	_, _, err := client.Timezone.List(context.TODO())
+
+	var server *mailerlite.ServerError
+	assert.True(t, errors.As(err, &server))
+	assert.Equal(t, "Service Unavailable", server.Message)
+	assert.True(t, errors.Is(err, mailerlite.ErrServer))
+}
+
+func TestTypedErrorsAreViewsOfErrorResponse(t *testing.T) {
+	client := errorClient(http.StatusNotFound, `{"message": "Resource not found."}`)
+
+	_, _, err := client.Subscriber.Get(context.TODO(), &mailerlite.GetSubscriberOptions{SubscriberID: "1"})
+
+	// The error is still an *ErrorResponse, as before the typed errors.
+	errorResponse, ok := err.(*mailerlite.ErrorResponse)
+	assert.True(t, ok)
+	assert.Equal(t, "Resource not found.", errorResponse.Message)
+
+	var validation *mailerlite.ValidationError
+	assert.False(t, errors.As(err, &validation))
+	var server *mailerlite.ServerError
+	assert.False(t, errors.As(err, &server))
+
+	var notFound *mailerlite.NotFoundError
+	assert.True(t, errors.As(err, &notFound))
+	assert.True(t, errors.Is(notFound, mailerlite.ErrNotFound))
+}
+
+func TestSentinelErrors(t *testing.T) {
+	client := errorClient(http.StatusUnauthorized, `{"message": "Unauthenticated."}`)
+
+	_, _, err := client.Timezone.List(context.TODO())
+	assert.True(t, errors.Is(err, mailerlite.ErrUnauthorized))
+
+	client = errorClient(http.StatusBadRequest, `{"message": "Bad Request"}`)
+
+	_, _, err = client.Timezone.List(context.TODO())
+	assert.IsType(t, &mailerlite.ErrorResponse{}, err)
+	assert.False(t, errors.Is(err, mailerlite.ErrNotFound))
+
+	rateErr := &mailerlite.RateLimitError{}
+	assert.True(t, errors.Is(rateErr, mailerlite.ErrRateLimited))
+}
@@ -0,0 +1,296 @@
+package mailerlite
+
+import (
+	"bufio"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// ExportFormat is the format written by SubscriberService.Export.
+type ExportFormat int
+
+const (
+	// ExportCSV writes a header row followed by a row per subscriber. Group
+	// names are joined with ";".
+	ExportCSV ExportFormat = iota
+	// ExportJSONL writes a JSON object per subscriber and line, with its keys
+	// in the order of the columns.
+	ExportJSONL
+)
+
+// exportFieldPrefix prefixes the columns holding custom fields.
+const exportFieldPrefix = "fields."
+
+// exportColumns are the columns exported for every subscriber, custom fields
+// follow as "fields.<key>".
+var exportColumns = []string{
+	"id", "email", "status", "source", "sent", "opens_count", "clicks_count", "open_rate", "click_rate",
+	"ip_address", "subscribed_at", "unsubscribed_at", "created_at", "updated_at", "opted_in_at", "optin_ip",
+	"groups",
+}
+
+var exportValues = map[string]func(s *Subscriber) interface{}{
+	"id":              func(s *Subscriber) interface{} { return s.ID },
+	"email":           func(s *Subscriber) interface{} { return s.Email },
+	"status":          func(s *Subscriber) interface{} { return s.Status },
+	"source":          func(s *Subscriber) interface{} { return s.Source },
+	"sent":            func(s *Subscriber) interface{} { return s.Sent },
+	"opens_count":     func(s *Subscriber) interface{} { return s.OpensCount },
+	"clicks_count":    func(s *Subscriber) interface{} { return s.ClicksCount },
+	"open_rate":       func(s *Subscriber) interface{} { return s.OpenRate },
+	"click_rate":      func(s *Subscriber) interface{} { return s.ClickRate },
+	"ip_address":      func(s *Subscriber) interface{} { return s.IPAddress },
+	"subscribed_at":   func(s *Subscriber) interface{} { return s.SubscribedAt },
+	"unsubscribed_at": func(s *Subscriber) interface{} { return s.UnsubscribedAt },
+	"created_at":      func(s *Subscriber) interface{} { return s.CreatedAt },
+	"updated_at":      func(s *Subscriber) interface{} { return s.UpdatedAt },
+	"opted_in_at":     func(s *Subscriber) interface{} { return s.OptedInAt },
+	"optin_ip":        func(s *Subscriber) interface{} { return s.OptinIP },
+	"groups": func(s *Subscriber) interface{} {
+		names := make([]string, len(s.Groups))
+		for i, group := range s.Groups {
+			names[i] = group.Name
+		}
+		return names
+	},
+}
+
+// ExportOptions - modifies the behavior of SubscriberService.Export method
+type ExportOptions struct {
+	Format ExportFormat
+	// Columns selects the columns to export, in order. By default every
+	// subscriber column is exported, followed by a "fields.<key>" column per
+	// field of the account, in the order FieldService.List returns them.
+	Columns []string
+	// Filters and Limit are passed on to SubscriberService.List.
+	Filters *[]Filter
+	Limit   int
+	// Checkpoint resumes an export from a checkpoint of a previous one. The
+	// CSV header is not written again.
+	Checkpoint *ExportCheckpoint
+	// OnCheckpoint is called every time the subscribers of a page have all
+	// been written and flushed to the writer.
+	OnCheckpoint func(checkpoint ExportCheckpoint)
+}
+
+// ExportCheckpoint is the position of an export, saving it allows to resume
+// an interrupted export with ExportOptions.Checkpoint.
+type ExportCheckpoint struct {
+	// Cursor is the cursor of the page of the next subscriber to export.
+	Cursor string `json:"cursor"`
+	// Offset is the number of subscribers of that page already exported.
+	Offset int `json:"offset"`
+	// Written is the number of subscribers exported so far.
+	Written int `json:"written"`
+	// Done tells that every subscriber was exported.
+	Done bool `json:"done"`
+}
+
+// Export - writes every subscriber to w, one page at a time. An error stops the export, the returned
+// checkpoint then tells what was written to w and allows to resume it. Resuming relies on the pages
+// of the list not changing in between, subscribers added or removed meanwhile may be missed or
+// exported twice.
+func (s *subscriberService) Export(ctx context.Context, w io.Writer, options *ExportOptions) (*ExportCheckpoint, error) {
+	opts := ExportOptions{}
+	if options != nil {
+		opts = *options
+	}
+
+	columns, err := s.exportColumns(ctx, opts.Columns)
+	if err != nil {
+		return nil, err
+	}
+
+	var out exportWriter
+	switch opts.Format {
+	case ExportCSV:
+		out = &csvExportWriter{w: csv.NewWriter(w)}
+	case ExportJSONL:
+		out = &jsonlExportWriter{w: bufio.NewWriter(w)}
+	default:
+		return nil, fmt.Errorf("mailerlite: unknown export format %d", opts.Format)
+	}
+
+	checkpoint := ExportCheckpoint{}
+	if opts.Checkpoint != nil {
+		checkpoint = *opts.Checkpoint
+	} else if err := out.header(columns); err != nil {
+		return &checkpoint, err
+	}
+	if checkpoint.Done {
+		return &checkpoint, nil
+	}
+
+	emit := func() error {
+		if err := out.flush(); err != nil {
+			return err
+		}
+		if opts.OnCheckpoint != nil {
+			opts.OnCheckpoint(checkpoint)
+		}
+		return nil
+	}
+
+	skip := checkpoint.Offset
+	values := make([]interface{}, len(columns))
+
+	pager := s.All(ctx, &ListSubscriberOptions{Filters: opts.Filters, Cursor: checkpoint.Cursor, Limit: opts.Limit})
+	defer pager.Close()
+	for pager.Next() {
+		if pager.Cursor() != checkpoint.Cursor {
+			if err := emit(); err != nil {
+				return &checkpoint, err
+			}
+			checkpoint.Cursor = pager.Cursor()
+			checkpoint.Offset = 0
+			skip = 0
+		}
+		if skip > 0 {
+			skip--
+			continue
+		}
+
+		subscriber := pager.Item()
+		for i, column := range columns {
+			values[i] = exportValue(&subscriber, column)
+		}
+		if err := out.write(columns, values); err != nil {
+			return &checkpoint, err
+		}
+		checkpoint.Offset++
+		checkpoint.Written++
+	}
+
+	if err := pager.Err(); err != nil {
+		if flushErr := out.flush(); flushErr != nil {
+			return &checkpoint, flushErr
+		}
+		return &checkpoint, err
+	}
+
+	checkpoint = ExportCheckpoint{Written: checkpoint.Written, Done: true}
+	if err := emit(); err != nil {
+		return &checkpoint, err
+	}
+	return &checkpoint, nil
+}
+
+// exportColumns checks the selected columns, or returns the default ones.
+func (s *subscriberService) exportColumns(ctx context.Context, selected []string) ([]string, error) {
+	schema, err := s.client.Field.Schema(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(selected) == 0 {
+		columns := append([]string{}, exportColumns...)
+		for _, field := range schema.Fields() {
+			columns = append(columns, exportFieldPrefix+field.Key)
+		}
+		return columns, nil
+	}
+
+	for _, column := range selected {
+		if key := strings.TrimPrefix(column, exportFieldPrefix); key != column {
+			if _, ok := schema.Field(key); !ok {
+				return nil, fmt.Errorf("%w: %s", ErrUnknownField, key)
+			}
+			continue
+		}
+		if _, ok := exportValues[column]; !ok {
+			return nil, fmt.Errorf("mailerlite: unknown export column %q", column)
+		}
+	}
+	return selected, nil
+}
+
+func exportValue(subscriber *Subscriber, column string) interface{} {
+	if key := strings.TrimPrefix(column, exportFieldPrefix); key != column {
+		return subscriber.Fields[key]
+	}
+	return exportValues[column](subscriber)
+}
+
+type exportWriter interface {
+	header(columns []string) error
+	write(columns []string, values []interface{}) error
+	flush() error
+}
+
+type csvExportWriter struct {
+	w      *csv.Writer
+	record []string
+}
+
+func (e *csvExportWriter) header(columns []string) error {
+	return e.w.Write(columns)
+}
+
+func (e *csvExportWriter) write(columns []string, values []interface{}) error {
+	e.record = e.record[:0]
+	for _, value := range values {
+		e.record = append(e.record, csvValue(value))
+	}
+	return e.w.Write(e.record)
+}
+
+func (e *csvExportWriter) flush() error {
+	e.w.Flush()
+	return e.w.Error()
+}
+
+func csvValue(value interface{}) string {
+	switch v := value.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case []string:
+		return strings.Join(v, ";")
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case fmt.Stringer:
+		return v.String()
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+type jsonlExportWriter struct {
+	w *bufio.Writer
+}
+
+func (e *jsonlExportWriter) header(columns []string) error {
+	return nil
+}
+
+func (e *jsonlExportWriter) write(columns []string, values []interface{}) error {
+	e.w.WriteByte('{')
+	for i, column := range columns {
+		if i > 0 {
+			e.w.WriteByte(',')
+		}
+		key, err := json.Marshal(column)
+		if err != nil {
+			return err
+		}
+		value, err := json.Marshal(values[i])
+		if err != nil {
+			return err
+		}
+		e.w.Write(key)
+		e.w.WriteByte(':')
+		e.w.Write(value)
+	}
+	_, err := e.w.WriteString("}\n")
+	return err
+}
+
+func (e *jsonlExportWriter) flush() error {
+	return e.w.Flush()
+}
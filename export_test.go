@@ -0,0 +1,135 @@
+package mailerlite_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/mailerlite/mailerlite-go"
+	"github.com/mailerlite/mailerlite-go/mailerlitetest"
+	"github.com/stretchr/testify/assert"
+)
+
+func exportServer(t *testing.T, n int) (*mailerlitetest.Server, *mailerlite.Client) {
+	server := mailerlitetest.NewServer()
+	client := server.Client()
+
+	group, _, err := client.Group.Create(context.TODO(), "Analysts")
+	assert.NoError(t, err)
+
+	for i := 0; i < n; i++ {
+		_, _, err := client.Subscriber.Upsert(context.TODO(), &mailerlite.UpsertSubscriber{
+			Email:  fmt.Sprintf("subscriber-%02d@example.com", i),
+			Fields: map[string]interface{}{"name": fmt.Sprintf("Subscriber %d", i)},
+			Groups: []string{group.Data.ID},
+		})
+		assert.NoError(t, err)
+	}
+	return server, client
+}
+
+func TestExportCSV(t *testing.T) {
+	server, client := exportServer(t, 25)
+	defer server.Close()
+
+	var buf bytes.Buffer
+	var checkpoints []mailerlite.ExportCheckpoint
+	checkpoint, err := client.Subscriber.Export(context.TODO(), &buf, &mailerlite.ExportOptions{
+		Limit: 10,
+		OnCheckpoint: func(checkpoint mailerlite.ExportCheckpoint) {
+			checkpoints = append(checkpoints, checkpoint)
+		},
+	})
+
+	assert.NoError(t, err)
+	assert.True(t, checkpoint.Done)
+	assert.Equal(t, 25, checkpoint.Written)
+	assert.Len(t, checkpoints, 3)
+
+	records, err := csv.NewReader(&buf).ReadAll()
+	assert.NoError(t, err)
+	assert.Len(t, records, 26)
+
+	header := records[0]
+	assert.Equal(t, "id", header[0])
+	assert.Contains(t, header, "groups")
+	assert.Equal(t, "fields.z_i_p", header[len(header)-1])
+
+	row := make(map[string]string)
+	for i, column := range header {
+		row[column] = records[1][i]
+	}
+	assert.Contains(t, row["email"], "@example.com")
+	assert.Contains(t, row["fields.name"], "Subscriber")
+	assert.Equal(t, "Analysts", row["groups"])
+}
+
+func TestExportJSONLColumns(t *testing.T) {
+	server, client := exportServer(t, 3)
+	defer server.Close()
+
+	var buf bytes.Buffer
+	_, err := client.Subscriber.Export(context.TODO(), &buf, &mailerlite.ExportOptions{
+		Format:  mailerlite.ExportJSONL,
+		Columns: []string{"email", "fields.name", "groups"},
+	})
+	assert.NoError(t, err)
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	assert.Len(t, lines, 3)
+	assert.True(t, strings.HasPrefix(lines[0], `{"email":`))
+
+	var row map[string]interface{}
+	assert.NoError(t, json.Unmarshal([]byte(lines[0]), &row))
+	assert.Len(t, row, 3)
+	assert.Equal(t, []interface{}{"Analysts"}, row["groups"])
+
+	_, err = client.Subscriber.Export(context.TODO(), &buf, &mailerlite.ExportOptions{Columns: []string{"fields.job_title"}})
+	assert.ErrorIs(t, err, mailerlite.ErrUnknownField)
+
+	_, err = client.Subscriber.Export(context.TODO(), &buf, &mailerlite.ExportOptions{Columns: []string{"nickname"}})
+	assert.Error(t, err)
+}
+
+func TestExportResume(t *testing.T) {
+	server, client := exportServer(t, 25)
+	defer server.Close()
+
+	ctx, cancel := context.WithCancel(context.TODO())
+	defer cancel()
+
+	var buf bytes.Buffer
+	checkpoint, err := client.Subscriber.Export(ctx, &buf, &mailerlite.ExportOptions{
+		Format: mailerlite.ExportJSONL,
+		Limit:  10,
+		OnCheckpoint: func(mailerlite.ExportCheckpoint) {
+			cancel()
+		},
+	})
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.False(t, checkpoint.Done)
+	assert.Equal(t, 20, checkpoint.Written)
+	assert.Equal(t, 10, checkpoint.Offset)
+	assert.NotEmpty(t, checkpoint.Cursor)
+
+	checkpoint, err = client.Subscriber.Export(context.TODO(), &buf, &mailerlite.ExportOptions{
+		Format:     mailerlite.ExportJSONL,
+		Limit:      10,
+		Checkpoint: checkpoint,
+	})
+	assert.NoError(t, err)
+	assert.True(t, checkpoint.Done)
+	assert.Equal(t, 25, checkpoint.Written)
+
+	emails := make(map[string]bool)
+	for _, line := range strings.Split(strings.TrimSpace(buf.String()), "\n") {
+		var row map[string]interface{}
+		assert.NoError(t, json.Unmarshal([]byte(line), &row))
+		emails[row["email"].(string)] = true
+	}
+	assert.Len(t, emails, 25)
+}
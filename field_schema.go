@@ -0,0 +1,309 @@
+package mailerlite
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Field types of the API.
+const (
+	FieldTypeText   = "text"
+	FieldTypeNumber = "number"
+	FieldTypeDate   = "date"
+)
+
+// FieldDateLayout is the layout of the values of date fields.
+const FieldDateLayout = "2006-01-02"
+
+// ErrUnknownField is returned when a field key does not match any field of
+// the account.
+var ErrUnknownField = errors.New("mailerlite: unknown field")
+
+// ErrInvalidFieldValue is returned when a value does not match the type of
+// its field.
+var ErrInvalidFieldValue = errors.New("mailerlite: invalid field value")
+
+// FieldSchemaError reports the field values a FieldSchema rejected.
+type FieldSchemaError struct {
+	Unknown []string     // Unknown field keys, sorted.
+	Invalid []FieldError // Invalid values, sorted by field.
+}
+
+func (e *FieldSchemaError) Error() string {
+	var parts []string
+	if len(e.Unknown) > 0 {
+		parts = append(parts, fmt.Sprintf("%v: %s", ErrUnknownField, strings.Join(e.Unknown, ", ")))
+	}
+	for _, invalid := range e.Invalid {
+		parts = append(parts, invalid.Error())
+	}
+	return strings.Join(parts, "; ")
+}
+
+// Is matches ErrUnknownField when keys are unknown and ErrInvalidFieldValue
+// when values are invalid.
+func (e *FieldSchemaError) Is(target error) bool {
+	return (target == ErrUnknownField && len(e.Unknown) > 0) ||
+		(target == ErrInvalidFieldValue && len(e.Invalid) > 0)
+}
+
+// FieldSchema holds the fields of an account, to check and convert custom
+// field values before sending them to the API.
+//
+//	schema, err := client.Field.Schema(ctx)
+//	...
+//	fields, err := schema.Values().
+//		Set("name", "Example").
+//		Set("birthday", time.Date(1990, 1, 31, 0, 0, 0, 0, time.UTC)).
+//		Map()
+//	...
+//	_, _, err = client.Subscriber.Upsert(ctx, &mailerlite.UpsertSubscriber{Email: "example@example.com", Fields: fields})
+type FieldSchema struct {
+	fields []Field
+	byKey  map[string]Field
+}
+
+// NewFieldSchema returns a schema of the given fields.
+func NewFieldSchema(fields []Field) *FieldSchema {
+	schema := &FieldSchema{fields: fields, byKey: make(map[string]Field, len(fields))}
+	for _, field := range fields {
+		schema.byKey[field.Key] = field
+	}
+	return schema
+}
+
+// Schema - returns the schema of all the fields of the account
+func (s *fieldService) Schema(ctx context.Context) (*FieldSchema, error) {
+	var fields []Field
+	pager := s.All(ctx, nil)
+	for pager.Next() {
+		fields = append(fields, pager.Item())
+	}
+	if err := pager.Err(); err != nil {
+		return nil, err
+	}
+	return NewFieldSchema(fields), nil
+}
+
+// Fields returns the fields of the schema, in the order they were listed.
+func (s *FieldSchema) Fields() []Field {
+	return s.fields
+}
+
+// Field returns the field with the given key.
+func (s *FieldSchema) Field(key string) (Field, bool) {
+	field, ok := s.byKey[key]
+	return field, ok
+}
+
+// Validate checks the values against the schema and returns them converted
+// to what the API expects: numbers for number fields and FieldDateLayout
+// strings for date fields. Nil values, which clear a field, are kept. The
+// error is a *FieldSchemaError.
+func (s *FieldSchema) Validate(values map[string]interface{}) (map[string]interface{}, error) {
+	converted := make(map[string]interface{}, len(values))
+	schemaErr := &FieldSchemaError{}
+
+	for key, value := range values {
+		field, ok := s.byKey[key]
+		if !ok {
+			schemaErr.Unknown = append(schemaErr.Unknown, key)
+			continue
+		}
+
+		v, err := convertFieldValue(field.Type, value)
+		if err != nil {
+			schemaErr.Invalid = append(schemaErr.Invalid, FieldError{Field: "fields." + key, Messages: []string{err.Error()}})
+			continue
+		}
+		converted[key] = v
+	}
+
+	if len(schemaErr.Unknown) > 0 || len(schemaErr.Invalid) > 0 {
+		sort.Strings(schemaErr.Unknown)
+		sort.Slice(schemaErr.Invalid, func(i, j int) bool {
+			return schemaErr.Invalid[i].Field < schemaErr.Invalid[j].Field
+		})
+		return nil, schemaErr
+	}
+	return converted, nil
+}
+
+// Text returns the value of a text field.
+func (s *FieldSchema) Text(values map[string]interface{}, key string) (string, error) {
+	v, err := s.value(values, key, FieldTypeText)
+	if v == nil || err != nil {
+		return "", err
+	}
+	return v.(string), nil
+}
+
+// Number returns the value of a number field.
+func (s *FieldSchema) Number(values map[string]interface{}, key string) (float64, error) {
+	v, err := s.value(values, key, FieldTypeNumber)
+	if v == nil || err != nil {
+		return 0, err
+	}
+	return v.(float64), nil
+}
+
+// Date returns the value of a date field, in UTC.
+func (s *FieldSchema) Date(values map[string]interface{}, key string) (time.Time, error) {
+	v, err := s.value(values, key, FieldTypeDate)
+	if v == nil || err != nil {
+		return time.Time{}, err
+	}
+	return time.Parse(FieldDateLayout, v.(string))
+}
+
+// value returns the converted value of the field, nil when it is not set.
+func (s *FieldSchema) value(values map[string]interface{}, key, fieldType string) (interface{}, error) {
+	field, ok := s.byKey[key]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrUnknownField, key)
+	}
+	if field.Type != fieldType {
+		return nil, fmt.Errorf("mailerlite: field %s is a %s field", key, field.Type)
+	}
+
+	v, err := convertFieldValue(field.Type, values[key])
+	if err != nil {
+		return nil, fmt.Errorf("fields.%s: %w", key, err)
+	}
+	if n, ok := v.(json.Number); ok {
+		return n.Float64()
+	}
+	if i, ok := v.(int64); ok {
+		return float64(i), nil
+	}
+	return v, nil
+}
+
+// FieldValues builds custom field values checked against a FieldSchema.
+type FieldValues struct {
+	schema *FieldSchema
+	values map[string]interface{}
+}
+
+// Values returns an empty FieldValues of the schema.
+func (s *FieldSchema) Values() *FieldValues {
+	return &FieldValues{schema: s, values: make(map[string]interface{})}
+}
+
+// Set sets the value of a field, nil clearing it.
+func (v *FieldValues) Set(key string, value interface{}) *FieldValues {
+	v.values[key] = value
+	return v
+}
+
+// Map returns the values converted by FieldSchema.Validate.
+func (v *FieldValues) Map() (map[string]interface{}, error) {
+	return v.schema.Validate(v.values)
+}
+
+// convertFieldValue converts value to the form the API expects for fields of
+// the given type.
+func convertFieldValue(fieldType string, value interface{}) (interface{}, error) {
+	if value == nil {
+		return nil, nil
+	}
+
+	switch fieldType {
+	case FieldTypeNumber:
+		return convertNumber(value)
+	case FieldTypeDate:
+		return convertDate(value)
+	default:
+		switch v := value.(type) {
+		case string:
+			return v, nil
+		case fmt.Stringer:
+			return v.String(), nil
+		}
+	}
+	return nil, fmt.Errorf("%w: %T for a %s field", ErrInvalidFieldValue, value, fieldType)
+}
+
+func convertNumber(value interface{}) (interface{}, error) {
+	switch v := value.(type) {
+	case int:
+		return int64(v), nil
+	case int8:
+		return int64(v), nil
+	case int16:
+		return int64(v), nil
+	case int32:
+		return int64(v), nil
+	case int64:
+		return v, nil
+	case uint:
+		return convertUint(uint64(v)), nil
+	case uint8:
+		return int64(v), nil
+	case uint16:
+		return int64(v), nil
+	case uint32:
+		return int64(v), nil
+	case uint64:
+		return convertUint(v), nil
+	case float32:
+		return float64(v), nil
+	case float64:
+		return v, nil
+	case json.Number:
+		if _, err := v.Float64(); err != nil {
+			return nil, fmt.Errorf("%w: %q is not a number", ErrInvalidFieldValue, v)
+		}
+		return v, nil
+	case string:
+		s := strings.TrimSpace(v)
+		if s == "" {
+			return nil, nil
+		}
+		if _, err := strconv.ParseFloat(s, 64); err != nil {
+			return nil, fmt.Errorf("%w: %q is not a number", ErrInvalidFieldValue, v)
+		}
+		return json.Number(s), nil
+	}
+	return nil, fmt.Errorf("%w: %T for a number field", ErrInvalidFieldValue, value)
+}
+
+// convertUint returns v as an int64 like other integers, or as a
+// json.Number when it does not fit.
+func convertUint(v uint64) interface{} {
+	if v > math.MaxInt64 {
+		return json.Number(strconv.FormatUint(v, 10))
+	}
+	return int64(v)
+}
+
+func convertDate(value interface{}) (interface{}, error) {
+	switch v := value.(type) {
+	case time.Time:
+		return v.Format(FieldDateLayout), nil
+	case *time.Time:
+		if v == nil {
+			return nil, nil
+		}
+		return v.Format(FieldDateLayout), nil
+	case string:
+		s := strings.TrimSpace(v)
+		if s == "" {
+			return nil, nil
+		}
+		for _, layout := range []string{FieldDateLayout, "2006-01-02 15:04:05", time.RFC3339} {
+			if t, err := time.Parse(layout, s); err == nil {
+				return t.Format(FieldDateLayout), nil
+			}
+		}
+		return nil, fmt.Errorf("%w: %q is not a date", ErrInvalidFieldValue, v)
+	}
+	return nil, fmt.Errorf("%w: %T for a date field", ErrInvalidFieldValue, value)
+}
@@ -0,0 +1,116 @@
+package mailerlite_test
+
+import (
+	"context"
+	"encoding/json"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/mailerlite/mailerlite-go"
+	"github.com/mailerlite/mailerlite-go/mailerlitetest"
+	"github.com/stretchr/testify/assert"
+)
+
+var testSchema = mailerlite.NewFieldSchema([]mailerlite.Field{
+	{Key: "name", Type: mailerlite.FieldTypeText},
+	{Key: "score", Type: mailerlite.FieldTypeNumber},
+	{Key: "birthday", Type: mailerlite.FieldTypeDate},
+})
+
+func TestFieldSchemaValidate(t *testing.T) {
+	fields, err := testSchema.Values().
+		Set("name", "Example").
+		Set("score", "4.5").
+		Set("birthday", time.Date(1990, 1, 31, 12, 0, 0, 0, time.UTC)).
+		Map()
+
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{
+		"name":     "Example",
+		"score":    json.Number("4.5"),
+		"birthday": "1990-01-31",
+	}, fields)
+
+	fields, err = testSchema.Validate(map[string]interface{}{"score": 3, "birthday": "1990-01-31 00:00:00", "name": nil})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(3), fields["score"])
+	assert.Equal(t, "1990-01-31", fields["birthday"])
+	assert.Nil(t, fields["name"])
+
+	fields, err = testSchema.Values().Set("score", uint(3)).Map()
+	assert.NoError(t, err)
+	assert.Equal(t, int64(3), fields["score"])
+
+	fields, err = testSchema.Values().Set("score", uint64(math.MaxUint64)).Map()
+	assert.NoError(t, err)
+	assert.Equal(t, json.Number("18446744073709551615"), fields["score"])
+
+	_, err = testSchema.Validate(map[string]interface{}{
+		"nmae":     "Example",
+		"score":    "high",
+		"birthday": 1990,
+	})
+
+	var schemaErr *mailerlite.FieldSchemaError
+	assert.ErrorAs(t, err, &schemaErr)
+	assert.ErrorIs(t, err, mailerlite.ErrUnknownField)
+	assert.ErrorIs(t, err, mailerlite.ErrInvalidFieldValue)
+	assert.Equal(t, []string{"nmae"}, schemaErr.Unknown)
+	assert.Len(t, schemaErr.Invalid, 2)
+	assert.Equal(t, "fields.birthday", schemaErr.Invalid[0].Field)
+	assert.Equal(t, "fields.score", schemaErr.Invalid[1].Field)
+}
+
+func TestFieldSchemaAccessors(t *testing.T) {
+	var values map[string]interface{}
+	assert.NoError(t, json.Unmarshal([]byte(`{"name": "Example", "score": 4.5, "birthday": "1990-01-31", "empty": null}`), &values))
+
+	name, err := testSchema.Text(values, "name")
+	assert.NoError(t, err)
+	assert.Equal(t, "Example", name)
+
+	score, err := testSchema.Number(values, "score")
+	assert.NoError(t, err)
+	assert.Equal(t, 4.5, score)
+
+	birthday, err := testSchema.Date(values, "birthday")
+	assert.NoError(t, err)
+	assert.Equal(t, time.Date(1990, 1, 31, 0, 0, 0, 0, time.UTC), birthday)
+
+	_, err = testSchema.Number(values, "name")
+	assert.Error(t, err)
+
+	_, err = testSchema.Text(values, "empty")
+	assert.ErrorIs(t, err, mailerlite.ErrUnknownField)
+
+	values["score"] = "n/a"
+	_, err = testSchema.Number(values, "score")
+	assert.ErrorIs(t, err, mailerlite.ErrInvalidFieldValue)
+}
+
+func TestFieldSchemaUpsert(t *testing.T) {
+	server := mailerlitetest.NewServer()
+	defer server.Close()
+
+	client := server.Client()
+	_, _, err := client.Field.Create(context.TODO(), "Score", mailerlite.FieldTypeNumber)
+	assert.NoError(t, err)
+
+	schema, err := client.Field.Schema(context.TODO())
+	assert.NoError(t, err)
+
+	field, ok := schema.Field("score")
+	assert.True(t, ok)
+	assert.Equal(t, mailerlite.FieldTypeNumber, field.Type)
+
+	fields, err := schema.Values().Set("name", "Example").Set("score", "42").Map()
+	assert.NoError(t, err)
+
+	subscriber, _, err := client.Subscriber.Upsert(context.TODO(), &mailerlite.UpsertSubscriber{Email: "example@example.com", Fields: fields})
+	assert.NoError(t, err)
+
+	score, err := schema.Number(subscriber.Data.Fields, "score")
+	assert.NoError(t, err)
+	assert.Equal(t, float64(42), score)
+}
@@ -11,6 +11,8 @@ const fieldEndpoint = "/fields"
 // FieldService defines an interface for field-related operations.
 type FieldService interface {
 	List(ctx context.Context, options *ListFieldOptions) (*RootFields, *Response, error)
+	All(ctx context.Context, options *ListFieldOptions) *Pager[Field]
+	Schema(ctx context.Context) (*FieldSchema, error)
 	Create(ctx context.Context, fieldName, fieldType string) (*RootField, *Response, error)
 	Update(ctx context.Context, fieldID, fieldName string) (*RootField, *Response, error)
 	Delete(ctx context.Context, fieldID string) (*Response, error)
@@ -61,6 +63,25 @@ func (s *fieldService) List(ctx context.Context, options *ListFieldOptions) (*Ro
 	return root, res, nil
 }
 
+// All - returns a Pager over all the fields, starting at options.Page
+func (s *fieldService) All(ctx context.Context, options *ListFieldOptions) *Pager[Field] {
+	opts := ListFieldOptions{}
+	if options != nil {
+		opts = *options
+	}
+
+	return newPagePager(ctx, opts.Page, func(ctx context.Context, page int) ([]Field, *Links, *Meta, *Response, error) {
+		pageOpts := opts
+		pageOpts.Page = page
+
+		root, res, err := s.List(ctx, &pageOpts)
+		if err != nil {
+			return nil, nil, nil, res, err
+		}
+		return root.Data, &root.Links, &root.Meta, res, nil
+	})
+}
+
 func (s *fieldService) Create(ctx context.Context, fieldName, fieldType string) (*RootField, *Response, error) {
 	body := map[string]interface{}{
 		"name": fieldName,
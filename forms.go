@@ -11,10 +11,12 @@ const formEndpoint = "/forms"
 // FormService defines an interface for form-related operations.
 type FormService interface {
 	List(ctx context.Context, options *ListFormOptions) (*RootForms, *Response, error)
+	All(ctx context.Context, options *ListFormOptions) *Pager[Form]
 	Get(ctx context.Context, formID string) (*RootForm, *Response, error)
 	Update(ctx context.Context, formID, formName string) (*RootForm, *Response, error)
 	Delete(ctx context.Context, formID string) (*Response, error)
 	Subscribers(ctx context.Context, options *ListFormSubscriberOptions) (*RootSubscribers, *Response, error)
+	AllSubscribers(ctx context.Context, options *ListFormSubscriberOptions) *Pager[Subscriber]
 }
 
 // formService implements FormsService.
@@ -37,12 +39,12 @@ type Form struct {
 	Type               string                 `json:"type"`
 	Slug               string                 `json:"slug"`
 	Name               string                 `json:"name"`
-	CreatedAt          string                 `json:"created_at"`
+	CreatedAt          Timestamp              `json:"created_at"`
 	ConversionsCount   int                    `json:"conversions_count"`
 	ConversionsRate    ConversionRate         `json:"conversions_rate"`
 	OpensCount         int                    `json:"opens_count"`
 	Settings           map[string]interface{} `json:"settings"`
-	LastRegistrationAt interface{}            `json:"last_registration_at"`
+	LastRegistrationAt Timestamp              `json:"last_registration_at"`
 	Active             bool                   `json:"active"`
 	IsBroken           bool                   `json:"is_broken"`
 	HasContent         bool                   `json:"has_content"`
@@ -95,6 +97,25 @@ func (s *formService) List(ctx context.Context, options *ListFormOptions) (*Root
 	return root, res, nil
 }
 
+// All - returns a Pager over all the forms of options.Type, starting at options.Page
+func (s *formService) All(ctx context.Context, options *ListFormOptions) *Pager[Form] {
+	opts := ListFormOptions{}
+	if options != nil {
+		opts = *options
+	}
+
+	return newPagePager(ctx, opts.Page, func(ctx context.Context, page int) ([]Form, *Links, *Meta, *Response, error) {
+		pageOpts := opts
+		pageOpts.Page = page
+
+		root, res, err := s.List(ctx, &pageOpts)
+		if err != nil {
+			return nil, nil, nil, res, err
+		}
+		return root.Data, &root.Links, &root.Meta, res, nil
+	})
+}
+
 func (s *formService) Get(ctx context.Context, formID string) (*RootForm, *Response, error) {
 	path := fmt.Sprintf("%s/%s", formEndpoint, formID)
 	req, err := s.client.newRequest(http.MethodGet, path, nil)
@@ -161,3 +182,22 @@ func (s *formService) Subscribers(ctx context.Context, options *ListFormSubscrib
 
 	return root, res, nil
 }
+
+// AllSubscribers - returns a Pager over all the subscribers of a form, starting at options.Page
+func (s *formService) AllSubscribers(ctx context.Context, options *ListFormSubscriberOptions) *Pager[Subscriber] {
+	opts := ListFormSubscriberOptions{}
+	if options != nil {
+		opts = *options
+	}
+
+	return newPagePager(ctx, opts.Page, func(ctx context.Context, page int) ([]Subscriber, *Links, *Meta, *Response, error) {
+		pageOpts := opts
+		pageOpts.Page = page
+
+		root, res, err := s.Subscribers(ctx, &pageOpts)
+		if err != nil {
+			return nil, nil, nil, res, err
+		}
+		return root.Data, &root.Links, &root.Meta, res, nil
+	})
+}
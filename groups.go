@@ -3,6 +3,7 @@ package mailerlite
 import (
 	"context"
 	"fmt"
+	"io"
 	"net/http"
 )
 
@@ -11,13 +12,19 @@ const groupEndpoint = "/groups"
 // GroupService defines an interface for group-related operations.
 type GroupService interface {
 	List(ctx context.Context, options *ListGroupOptions) (*RootGroups, *Response, error)
+	All(ctx context.Context, options *ListGroupOptions) *Pager[Group]
 	Create(ctx context.Context, groupName string) (*RootGroup, *Response, error)
 	Update(ctx context.Context, groupID string, groupName string) (*RootGroup, *Response, error)
 	Delete(ctx context.Context, groupID string) (*Response, error)
 	Subscribers(ctx context.Context, options *ListGroupSubscriberOptions) (*RootSubscribers, *Response, error)
+	AllSubscribers(ctx context.Context, options *ListGroupSubscriberOptions) *Pager[Subscriber]
 	Assign(ctx context.Context, groupID, subscriberID string) (*RootGroup, *Response, error)
 	UnAssign(ctx context.Context, groupID, subscriberID string) (*Response, error)
+	AssignMany(ctx context.Context, groupID string, subscribers []string, opts ...BulkOption) (*MembershipReport, error)
+	UnAssignMany(ctx context.Context, groupID string, subscribers []string, opts ...BulkOption) (*MembershipReport, error)
 	ImportSubscribers(ctx context.Context, groupID string, options *ImportSubscribersOptions) (*RootImportSubscribers, *Response, error)
+	SyncMembers(ctx context.Context, groupID string, desiredEmails []string, opts ...SyncOption) (*SyncReport, error)
+	ImportFile(ctx context.Context, groupID string, r io.Reader, options *ImportFileOptions) (*ImportFileResult, error)
 }
 
 // groupService implements GroupService.
@@ -48,7 +55,7 @@ type Group struct {
 	UnconfirmedCount  int       `json:"unconfirmed_count"`
 	BouncedCount      int       `json:"bounced_count"`
 	JunkCount         int       `json:"junk_count"`
-	CreatedAt         string    `json:"created_at"`
+	CreatedAt         Timestamp `json:"created_at"`
 }
 
 // ListGroupOptions - modifies the behavior of GroupService.List method
@@ -94,6 +101,25 @@ func (s *groupService) List(ctx context.Context, options *ListGroupOptions) (*Ro
 	return root, res, nil
 }
 
+// All - returns a Pager over all the groups, starting at options.Page
+func (s *groupService) All(ctx context.Context, options *ListGroupOptions) *Pager[Group] {
+	opts := ListGroupOptions{}
+	if options != nil {
+		opts = *options
+	}
+
+	return newPagePager(ctx, opts.Page, func(ctx context.Context, page int) ([]Group, *Links, *Meta, *Response, error) {
+		pageOpts := opts
+		pageOpts.Page = page
+
+		root, res, err := s.List(ctx, &pageOpts)
+		if err != nil {
+			return nil, nil, nil, res, err
+		}
+		return root.Data, &root.Links, &root.Meta, res, nil
+	})
+}
+
 func (s *groupService) Create(ctx context.Context, groupName string) (*RootGroup, *Response, error) {
 	body := map[string]interface{}{"name": groupName}
 	req, err := s.client.newRequest(http.MethodPost, groupEndpoint, body)
@@ -161,6 +187,25 @@ func (s *groupService) Subscribers(ctx context.Context, options *ListGroupSubscr
 	return root, res, nil
 }
 
+// AllSubscribers - returns a Pager over all the subscribers of a group, starting at options.Page
+func (s *groupService) AllSubscribers(ctx context.Context, options *ListGroupSubscriberOptions) *Pager[Subscriber] {
+	opts := ListGroupSubscriberOptions{}
+	if options != nil {
+		opts = *options
+	}
+
+	return newPagePager(ctx, opts.Page, func(ctx context.Context, page int) ([]Subscriber, *Links, *Meta, *Response, error) {
+		pageOpts := opts
+		pageOpts.Page = page
+
+		root, res, err := s.Subscribers(ctx, &pageOpts)
+		if err != nil {
+			return nil, nil, nil, res, err
+		}
+		return root.Data, &root.Links, &root.Meta, res, nil
+	})
+}
+
 func (s *groupService) Assign(ctx context.Context, groupID, subscriberID string) (*RootGroup, *Response, error) {
 	path := fmt.Sprintf("%s/%s/groups/%s", subscriberEndpoint, subscriberID, groupID)
 
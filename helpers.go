@@ -147,11 +147,17 @@ type ClickToOpenRate struct {
 }
 
 // NextPageToken is the page token to request the next page of the list
+//
+// Deprecated: the API does not return page tokens, cursor paginated lists
+// return their next cursor in Meta.NextCursor, see SubscriberService.All.
 func (l *Links) NextPageToken() (string, error) {
 	return l.nextPageToken()
 }
 
 // PrevPageToken is the page token to request the previous page of the list
+//
+// Deprecated: the API does not return page tokens, cursor paginated lists
+// return their previous cursor in Meta.PrevCursor.
 func (l *Links) PrevPageToken() (string, error) {
 	return l.prevPageToken()
 }
@@ -0,0 +1,417 @@
+package mailerlite
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/url"
+	"path"
+	"sort"
+	"strings"
+	"time"
+)
+
+// DefaultImportChunkSize is the number of subscribers GroupService.ImportFile
+// sends per import request unless ImportFileOptions.ChunkSize is set. It keeps
+// requests well within the size the API accepts.
+const DefaultImportChunkSize = 1000
+
+const (
+	// DefaultImportPollInterval is the delay before the first poll of
+	// SubscriberService.WaitForImport.
+	DefaultImportPollInterval = time.Second
+	// DefaultImportMaxPollInterval caps the delay between two polls of
+	// SubscriberService.WaitForImport.
+	DefaultImportMaxPollInterval = 30 * time.Second
+)
+
+// ImportFormat is the format of a file read by GroupService.ImportFile.
+type ImportFormat int
+
+const (
+	// ImportCSV is a CSV file with a header row naming the columns.
+	ImportCSV ImportFormat = iota
+	// ImportJSONL is a file with one JSON object per line, its keys being the columns.
+	ImportJSONL
+)
+
+// ImportFileOptions - modifies the behavior of GroupService.ImportFile method
+type ImportFileOptions struct {
+	Format ImportFormat
+	// EmailColumn is the column holding the email, "email" when empty.
+	EmailColumn string
+	// Mapping maps columns to field keys. When nil, the columns named after
+	// a field key are imported into that field. Other columns are ignored.
+	Mapping map[string]string
+	// ChunkSize is the number of subscribers per import request,
+	// DefaultImportChunkSize when zero.
+	ChunkSize int
+}
+
+// ImportRowError is the reason a row of the file was skipped.
+type ImportRowError struct {
+	Line int
+	Err  error
+}
+
+func (e *ImportRowError) Error() string {
+	return fmt.Sprintf("mailerlite: line %d: %v", e.Line, e.Err)
+}
+
+func (e *ImportRowError) Unwrap() error {
+	return e.Err
+}
+
+// ImportFileResult - result of GroupService.ImportFile
+type ImportFileResult struct {
+	// ImportIDs holds an import per request sent, to follow with
+	// SubscriberService.GetImport.
+	ImportIDs []string
+	// Rows is the number of subscribers sent.
+	Rows int
+	// Skipped holds the rows which were not sent.
+	Skipped []*ImportRowError
+}
+
+// ImportFile - imports the subscribers read from r into the group, in requests of up to
+// options.ChunkSize subscribers. The mapping is checked against the fields of the account
+// before anything is sent. Rows without an email or which can not be decoded are skipped and
+// reported, an error reading r or sending a request stops the import, the result then holds
+// the imports already started.
+func (s *groupService) ImportFile(ctx context.Context, groupID string, r io.Reader, options *ImportFileOptions) (*ImportFileResult, error) {
+	opts := ImportFileOptions{}
+	if options != nil {
+		opts = *options
+	}
+	if opts.EmailColumn == "" {
+		opts.EmailColumn = "email"
+	}
+	if opts.ChunkSize < 1 {
+		opts.ChunkSize = DefaultImportChunkSize
+	}
+
+	mapping, err := s.importMapping(ctx, opts.Mapping)
+	if err != nil {
+		return nil, err
+	}
+
+	var rows importReader
+	switch opts.Format {
+	case ImportCSV:
+		rows, err = newCSVImportReader(r, opts.EmailColumn, opts.Mapping)
+		if err != nil {
+			return nil, err
+		}
+	case ImportJSONL:
+		rows = newJSONLImportReader(r)
+	default:
+		return nil, fmt.Errorf("mailerlite: unknown import format %d", opts.Format)
+	}
+
+	result := &ImportFileResult{ImportIDs: []string{}}
+	chunk := make([]ImportSubscriber, 0, opts.ChunkSize)
+
+	send := func() error {
+		if len(chunk) == 0 {
+			return nil
+		}
+
+		root, _, err := s.ImportSubscribers(ctx, groupID, &ImportSubscribersOptions{Subscribers: chunk})
+		if err != nil {
+			return err
+		}
+		id, err := importIDFromURL(root.ImportProgressURL)
+		if err != nil {
+			return err
+		}
+
+		result.ImportIDs = append(result.ImportIDs, id)
+		result.Rows += len(chunk)
+		chunk = make([]ImportSubscriber, 0, opts.ChunkSize)
+		return nil
+	}
+
+	for {
+		line, row, err := rows.next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			var rowErr *ImportRowError
+			if errors.As(err, &rowErr) {
+				result.Skipped = append(result.Skipped, rowErr)
+				continue
+			}
+			return result, err
+		}
+
+		subscriber, err := importSubscriber(row, opts.EmailColumn, mapping)
+		if err != nil {
+			result.Skipped = append(result.Skipped, &ImportRowError{Line: line, Err: err})
+			continue
+		}
+
+		chunk = append(chunk, subscriber)
+		if len(chunk) == opts.ChunkSize {
+			if err := send(); err != nil {
+				return result, err
+			}
+		}
+	}
+
+	if err := send(); err != nil {
+		return result, err
+	}
+	return result, nil
+}
+
+// importMapping checks the mapping against the fields of the account. A nil
+// mapping maps every field key to itself.
+func (s *groupService) importMapping(ctx context.Context, mapping map[string]string) (map[string]string, error) {
+	schema, err := s.client.Field.Schema(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if mapping == nil {
+		mapping = make(map[string]string)
+		for _, field := range schema.Fields() {
+			mapping[field.Key] = field.Key
+		}
+		return mapping, nil
+	}
+
+	var unknown []string
+	for _, key := range mapping {
+		if _, ok := schema.Field(key); !ok {
+			unknown = append(unknown, key)
+		}
+	}
+	if len(unknown) > 0 {
+		sort.Strings(unknown)
+		return nil, fmt.Errorf("%w: %s", ErrUnknownField, strings.Join(unknown, ", "))
+	}
+
+	return mapping, nil
+}
+
+func importSubscriber(row map[string]interface{}, emailColumn string, mapping map[string]string) (ImportSubscriber, error) {
+	email, _ := row[emailColumn].(string)
+	email = strings.TrimSpace(email)
+	if email == "" {
+		return ImportSubscriber{}, fmt.Errorf("missing %s", emailColumn)
+	}
+
+	subscriber := ImportSubscriber{Email: email}
+	for column, key := range mapping {
+		value, ok := row[column]
+		if !ok || value == nil || value == "" {
+			continue
+		}
+		if subscriber.Fields == nil {
+			subscriber.Fields = make(map[string]interface{})
+		}
+		subscriber.Fields[key] = value
+	}
+	return subscriber, nil
+}
+
+// WaitOption configures SubscriberService.WaitForImport.
+type WaitOption func(*waitOptions)
+
+type waitOptions struct {
+	interval    time.Duration
+	maxInterval time.Duration
+	progress    func(imp *Import)
+}
+
+// WithPollInterval sets the delay before the first poll, doubled after every
+// poll which shows no progress up to max. The defaults are
+// DefaultImportPollInterval and DefaultImportMaxPollInterval.
+func WithPollInterval(interval, max time.Duration) WaitOption {
+	return func(o *waitOptions) {
+		o.interval = interval
+		o.maxInterval = max
+	}
+}
+
+// WithProgress calls fn with the import every time its Percent or Processed
+// changes, including once it is done.
+func WithProgress(fn func(imp *Import)) WaitOption {
+	return func(o *waitOptions) {
+		o.progress = fn
+	}
+}
+
+// WaitForImport - polls the import until it is done and returns it. The error is set when a
+// poll fails, or when ctx is done before the import.
+func (s *subscriberService) WaitForImport(ctx context.Context, importID string, opts ...WaitOption) (*Import, error) {
+	o := &waitOptions{interval: DefaultImportPollInterval, maxInterval: DefaultImportMaxPollInterval}
+	for _, opt := range opts {
+		opt(o)
+	}
+	if o.maxInterval < o.interval {
+		o.maxInterval = o.interval
+	}
+
+	delay := o.interval
+	var last *Import
+	for {
+		root, _, err := s.GetImport(ctx, importID)
+		if err != nil {
+			return nil, err
+		}
+		imp := &root.Data
+
+		progressed := last == nil || imp.Percent != last.Percent || imp.Processed != last.Processed
+		if (progressed || imp.Done) && o.progress != nil {
+			o.progress(imp)
+		}
+		if imp.Done {
+			return imp, nil
+		}
+		last = imp
+
+		if progressed {
+			delay = o.interval
+		} else {
+			delay *= 2
+			if delay > o.maxInterval {
+				delay = o.maxInterval
+			}
+		}
+
+		if err := sleep(ctx, delay); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// WaitForImportURL - same as WaitForImport, taking the import_progress_url returned by
+// GroupService.ImportSubscribers
+func (s *subscriberService) WaitForImportURL(ctx context.Context, progressURL string, opts ...WaitOption) (*Import, error) {
+	importID, err := importIDFromURL(progressURL)
+	if err != nil {
+		return nil, err
+	}
+	return s.WaitForImport(ctx, importID, opts...)
+}
+
+// importIDFromURL returns the import ID from the import_progress_url of an
+// import, its last path segment.
+func importIDFromURL(progressURL string) (string, error) {
+	u, err := url.Parse(progressURL)
+	if err != nil {
+		return "", err
+	}
+
+	id := path.Base(u.Path)
+	if id == "" || id == "." || id == "/" {
+		return "", fmt.Errorf("mailerlite: no import ID in %q", progressURL)
+	}
+	return id, nil
+}
+
+// importReader reads the rows of an import file. Rows which can not be
+// decoded are returned as an *ImportRowError, io.EOF ends the file.
+type importReader interface {
+	next() (line int, row map[string]interface{}, err error)
+}
+
+type csvImportReader struct {
+	r      *csv.Reader
+	header []string
+}
+
+func newCSVImportReader(r io.Reader, emailColumn string, mapping map[string]string) (*csvImportReader, error) {
+	cr := csv.NewReader(r)
+	cr.FieldsPerRecord = -1
+	cr.TrimLeadingSpace = true
+
+	header, err := cr.Read()
+	if err == io.EOF {
+		return nil, errors.New("mailerlite: missing CSV header")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	columns := make(map[string]bool, len(header))
+	for i := range header {
+		header[i] = strings.TrimSpace(strings.TrimPrefix(header[i], "\ufeff"))
+		columns[header[i]] = true
+	}
+
+	if !columns[emailColumn] {
+		return nil, fmt.Errorf("mailerlite: column %q not found", emailColumn)
+	}
+	for column := range mapping {
+		if !columns[column] {
+			return nil, fmt.Errorf("mailerlite: column %q not found", column)
+		}
+	}
+
+	return &csvImportReader{r: cr, header: header}, nil
+}
+
+func (r *csvImportReader) next() (int, map[string]interface{}, error) {
+	record, err := r.r.Read()
+	var parseErr *csv.ParseError
+	if errors.As(err, &parseErr) {
+		// The reader goes on with the next record.
+		return 0, nil, &ImportRowError{Line: parseErr.Line, Err: parseErr}
+	}
+	if err != nil {
+		return 0, nil, err
+	}
+	line, _ := r.r.FieldPos(0)
+
+	row := make(map[string]interface{}, len(record))
+	for i, value := range record {
+		if i < len(r.header) {
+			row[r.header[i]] = strings.TrimSpace(value)
+		}
+	}
+	return line, row, nil
+}
+
+type jsonlImportReader struct {
+	r    *bufio.Reader
+	line int
+}
+
+func newJSONLImportReader(r io.Reader) *jsonlImportReader {
+	return &jsonlImportReader{r: bufio.NewReader(r)}
+}
+
+func (r *jsonlImportReader) next() (int, map[string]interface{}, error) {
+	for {
+		data, err := r.r.ReadBytes('\n')
+		if err != nil && err != io.EOF {
+			return 0, nil, err
+		}
+		if len(data) == 0 && err == io.EOF {
+			return 0, nil, io.EOF
+		}
+		r.line++
+
+		data = bytes.TrimSpace(data)
+		if len(data) == 0 {
+			continue
+		}
+
+		dec := json.NewDecoder(bytes.NewReader(data))
+		dec.UseNumber()
+
+		var row map[string]interface{}
+		if err := dec.Decode(&row); err != nil {
+			return r.line, nil, &ImportRowError{Line: r.line, Err: err}
+		}
+		return r.line, row, nil
+	}
+}
@@ -0,0 +1,217 @@
+package mailerlite_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/mailerlite/mailerlite-go"
+	"github.com/mailerlite/mailerlite-go/mailerlitetest"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestImportFileCSV(t *testing.T) {
+	server := mailerlitetest.NewServer()
+	defer server.Close()
+
+	client := server.Client()
+	group, _, err := client.Group.Create(context.TODO(), "Imported")
+	assert.NoError(t, err)
+
+	file := strings.NewReader("Email,First name,Notes\n" +
+		"one@example.com,One,a\n" +
+		"two@example.com,Two,b\n" +
+		",Nobody,c\n" +
+		"three@example.com,,d\n" +
+		"four@example.com,Four\n" +
+		"five@example.com,Five,e\n")
+
+	result, err := client.Group.ImportFile(context.TODO(), group.Data.ID, file, &mailerlite.ImportFileOptions{
+		EmailColumn: "Email",
+		Mapping:     map[string]string{"First name": "name"},
+		ChunkSize:   2,
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, 5, result.Rows)
+	assert.Len(t, result.ImportIDs, 3)
+	assert.Len(t, result.Skipped, 1)
+	assert.Equal(t, 4, result.Skipped[0].Line)
+
+	for _, id := range result.ImportIDs {
+		imp, _, err := client.Subscriber.GetImport(context.TODO(), id)
+		assert.NoError(t, err)
+		assert.True(t, imp.Data.Done)
+	}
+
+	subscribers := server.Subscribers()
+	assert.Len(t, subscribers, 5)
+	for _, subscriber := range subscribers {
+		if subscriber.Email == "one@example.com" {
+			assert.Equal(t, "One", subscriber.Fields["name"])
+		}
+		assert.Len(t, subscriber.Groups, 1)
+	}
+}
+
+func TestImportFileCSVSkipsMalformedRows(t *testing.T) {
+	server := mailerlitetest.NewServer()
+	defer server.Close()
+
+	client := server.Client()
+	group, _, err := client.Group.Create(context.TODO(), "Imported")
+	assert.NoError(t, err)
+
+	file := strings.NewReader("Email,First name\n" +
+		"one@example.com,One\n" +
+		"two@example.com,T\"wo\n" +
+		"three@example.com,Three\n")
+
+	result, err := client.Group.ImportFile(context.TODO(), group.Data.ID, file, &mailerlite.ImportFileOptions{
+		EmailColumn: "Email",
+		Mapping:     map[string]string{"First name": "name"},
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, 2, result.Rows)
+	assert.Len(t, result.Skipped, 1)
+	assert.Equal(t, 3, result.Skipped[0].Line)
+	assert.ErrorIs(t, result.Skipped[0], csv.ErrBareQuote)
+	assert.Len(t, server.Subscribers(), 2)
+}
+
+func TestImportFileJSONL(t *testing.T) {
+	server := mailerlitetest.NewServer()
+	defer server.Close()
+
+	client := server.Client()
+	group, _, err := client.Group.Create(context.TODO(), "Imported")
+	assert.NoError(t, err)
+
+	file := strings.NewReader(`{"email": "one@example.com", "name": "One", "city": "Vilnius", "other": 1}` + "\n" +
+		"\n" +
+		`{"email": "two@example.com"` + "\n" +
+		`{"email": "three@example.com", "company": "Example"}`)
+
+	result, err := client.Group.ImportFile(context.TODO(), group.Data.ID, file, &mailerlite.ImportFileOptions{
+		Format: mailerlite.ImportJSONL,
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, 2, result.Rows)
+	assert.Len(t, result.ImportIDs, 1)
+	assert.Len(t, result.Skipped, 1)
+	assert.Equal(t, 3, result.Skipped[0].Line)
+
+	for _, subscriber := range server.Subscribers() {
+		if subscriber.Email == "one@example.com" {
+			assert.Equal(t, "One", subscriber.Fields["name"])
+			assert.Equal(t, "Vilnius", subscriber.Fields["city"])
+			assert.NotContains(t, subscriber.Fields, "other")
+		}
+	}
+}
+
+func TestImportFileMapping(t *testing.T) {
+	server := mailerlitetest.NewServer()
+	defer server.Close()
+
+	client := server.Client()
+	group, _, err := client.Group.Create(context.TODO(), "Imported")
+	assert.NoError(t, err)
+
+	_, err = client.Group.ImportFile(context.TODO(), group.Data.ID, strings.NewReader("email,title\n"), &mailerlite.ImportFileOptions{
+		Mapping: map[string]string{"title": "job_title"},
+	})
+	assert.ErrorIs(t, err, mailerlite.ErrUnknownField)
+	assert.Contains(t, err.Error(), "job_title")
+
+	_, err = client.Group.ImportFile(context.TODO(), group.Data.ID, strings.NewReader("mail,name\n"), nil)
+	assert.Error(t, err)
+	assert.False(t, errors.Is(err, mailerlite.ErrUnknownField))
+
+	assert.Empty(t, server.Subscribers())
+}
+
+func TestWaitForImport(t *testing.T) {
+	progress := []struct{ processed, percent int }{{0, 0}, {0, 0}, {50, 50}, {50, 50}, {100, 100}}
+	polls := 0
+
+	client := mailerlite.NewClient(testKey)
+	client.SetHttpClient(NewTestClient(func(req *http.Request) *http.Response {
+		assert.Equal(t, "/api/subscribers/import/import-id", req.URL.Path)
+
+		p := progress[polls]
+		polls++
+		body := fmt.Sprintf(`{"data": {"id": "import-id", "processed": %d, "percent": %d, "done": %t, "invalid": [{"email": "invalid"}]}}`,
+			p.processed, p.percent, p.percent == 100)
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       io.NopCloser(bytes.NewBufferString(body)),
+			Header:     make(http.Header),
+		}
+	}))
+
+	var percents []int
+	imp, err := client.Subscriber.WaitForImportURL(context.TODO(), "https://connect.mailerlite.com/api/subscribers/import/import-id",
+		mailerlite.WithPollInterval(time.Millisecond, 2*time.Millisecond),
+		mailerlite.WithProgress(func(imp *mailerlite.Import) {
+			percents = append(percents, imp.Percent)
+		}))
+
+	assert.NoError(t, err)
+	assert.True(t, imp.Done)
+	assert.Equal(t, "invalid", imp.Invalid[0].Email)
+	assert.Equal(t, len(progress), polls)
+	assert.Equal(t, []int{0, 50, 100}, percents)
+}
+
+func TestWaitForImportDoneWithoutProgress(t *testing.T) {
+	polls := 0
+
+	client := mailerlite.NewClient(testKey)
+	client.SetHttpClient(NewTestClient(func(req *http.Request) *http.Response {
+		polls++
+		body := fmt.Sprintf(`{"data": {"id": "import-id", "processed": 10, "percent": 100, "done": %t}}`, polls > 1)
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       io.NopCloser(bytes.NewBufferString(body)),
+			Header:     make(http.Header),
+		}
+	}))
+
+	var done []bool
+	_, err := client.Subscriber.WaitForImport(context.TODO(), "import-id",
+		mailerlite.WithPollInterval(time.Millisecond, 2*time.Millisecond),
+		mailerlite.WithProgress(func(imp *mailerlite.Import) {
+			done = append(done, imp.Done)
+		}))
+
+	assert.NoError(t, err)
+	assert.Equal(t, 2, polls)
+	assert.Equal(t, []bool{false, true}, done)
+}
+
+func TestWaitForImportContext(t *testing.T) {
+	client := mailerlite.NewClient(testKey)
+	client.SetHttpClient(NewTestClient(func(req *http.Request) *http.Response {
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       io.NopCloser(bytes.NewBufferString(`{"data": {"id": "import-id", "done": false}}`)),
+			Header:     make(http.Header),
+		}
+	}))
+
+	ctx, cancel := context.WithTimeout(context.TODO(), 20*time.Millisecond)
+	defer cancel()
+
+	_, err := client.Subscriber.WaitForImport(ctx, "import-id", mailerlite.WithPollInterval(time.Millisecond, 5*time.Millisecond))
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+}
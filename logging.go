@@ -0,0 +1,145 @@
+//go:build go1.21
+
+package mailerlite
+
+import (
+	"bytes"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// LogOption configures the logging set up by WithLogger.
+type LogOption func(*logConfig)
+
+type logConfig struct {
+	rules        []RedactionRule
+	maxBodyBytes int
+}
+
+// WithRedactionRules replaces the default redaction rules applied to the
+// logged path, query, bodies and errors. See DefaultRedactionRules.
+func WithRedactionRules(rules ...RedactionRule) LogOption {
+	return func(cfg *logConfig) {
+		cfg.rules = rules
+	}
+}
+
+// logBodyReadLimit is the number of bytes of a body read to be redacted.
+// Bodies are redacted before being truncated, so that a value cut in the
+// middle is not left out of the redaction.
+const logBodyReadLimit = 1 << 20
+
+// WithLogBodies includes request and response bodies, truncated to
+// maxBytes, at most 512KiB, when the logger is enabled for slog.LevelDebug.
+func WithLogBodies(maxBytes int) LogOption {
+	return func(cfg *logConfig) {
+		cfg.maxBodyBytes = maxBytes
+		if cfg.maxBodyBytes > logBodyReadLimit/2 {
+			cfg.maxBodyBytes = logBodyReadLimit / 2
+		}
+	}
+}
+
+// WithLogger logs every API call made by the client to logger.
+func WithLogger(logger *slog.Logger, opts ...LogOption) ClientOption {
+	return WithMiddleware(LoggingMiddleware(logger, opts...))
+}
+
+// LoggingMiddleware returns a Middleware logging the method, path, query,
+// status, latency and rate limit of every API call to logger. Successful
+// calls are logged at slog.LevelInfo and failed calls at slog.LevelError.
+// The Authorization header is never logged.
+func LoggingMiddleware(logger *slog.Logger, opts ...LogOption) Middleware {
+	cfg := &logConfig{rules: DefaultRedactionRules()}
+	for _, opt := range opts {
+		opt(cfg)
+	}
+
+	return func(next Doer) Doer {
+		return DoerFunc(func(req *http.Request) (*Response, error) {
+			ctx := req.Context()
+			debug := cfg.maxBodyBytes > 0 && logger.Enabled(ctx, slog.LevelDebug)
+
+			query, _ := url.QueryUnescape(req.URL.RawQuery)
+			attrs := []slog.Attr{
+				slog.String("method", req.Method),
+				slog.String("path", redact(req.URL.EscapedPath(), cfg.rules)),
+				slog.String("query", redact(query, cfg.rules)),
+			}
+			if debug {
+				attrs = append(attrs, slog.String("request_body", cfg.requestBody(req)))
+			}
+
+			start := time.Now()
+			res, err := next.Do(req)
+			attrs = append(attrs, slog.Duration("latency", time.Since(start)))
+
+			if res != nil && res.Response != nil {
+				attrs = append(attrs,
+					slog.Int("status", res.StatusCode),
+					slog.Group("rate_limit",
+						slog.String("limit", res.Header.Get(HeaderRateLimit)),
+						slog.String("remaining", res.Header.Get(HeaderRateRemaining)),
+						slog.String("retry_after", res.Header.Get(HeaderRateRetryAfter)),
+					),
+				)
+				if debug && err == nil {
+					attrs = append(attrs, slog.String("response_body", cfg.responseBody(res)))
+				}
+			}
+
+			if err != nil {
+				attrs = append(attrs, slog.String("error", redact(err.Error(), cfg.rules)))
+				logger.LogAttrs(ctx, slog.LevelError, "mailerlite request failed", attrs...)
+				return res, err
+			}
+
+			logger.LogAttrs(ctx, slog.LevelInfo, "mailerlite request", attrs...)
+			return res, err
+		})
+	}
+}
+
+// requestBody returns the redacted and truncated body of req without consuming it.
+func (cfg *logConfig) requestBody(req *http.Request) string {
+	if req.GetBody == nil {
+		return ""
+	}
+
+	body, err := req.GetBody()
+	if err != nil {
+		return ""
+	}
+	defer body.Close()
+
+	head, _ := io.ReadAll(io.LimitReader(body, logBodyReadLimit))
+
+	return cfg.truncate(redact(string(head), cfg.rules))
+}
+
+// responseBody returns the redacted and truncated body of res, leaving the
+// body intact for decoding.
+func (cfg *logConfig) responseBody(res *Response) string {
+	if res.Body == nil {
+		return ""
+	}
+
+	head, _ := io.ReadAll(io.LimitReader(res.Body, logBodyReadLimit))
+	res.Body = struct {
+		io.Reader
+		io.Closer
+	}{io.MultiReader(bytes.NewReader(head), res.Body), res.Body}
+
+	return cfg.truncate(redact(string(head), cfg.rules))
+}
+
+// truncate cuts a redacted body to maxBodyBytes.
+func (cfg *logConfig) truncate(body string) string {
+	if len(body) > cfg.maxBodyBytes {
+		return body[:cfg.maxBodyBytes]
+	}
+	return body
+}
@@ -0,0 +1,157 @@
+//go:build go1.21
+
+package mailerlite_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"log/slog"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/mailerlite/mailerlite-go"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLoggerRedactsSecrets(t *testing.T) {
+	testClient := NewTestClient(func(req *http.Request) *http.Response {
+		header := http.Header{}
+		header.Set(mailerlite.HeaderRateLimit, "120")
+		header.Set(mailerlite.HeaderRateRemaining, "119")
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Request:    req,
+			Header:     header,
+			Body:       io.NopCloser(strings.NewReader(`{"data": {"email": "client@example.com"}}`)),
+		}
+	})
+
+	buf := new(bytes.Buffer)
+	logger := slog.New(slog.NewJSONHandler(buf, &slog.HandlerOptions{Level: slog.LevelDebug}))
+
+	client := mailerlite.NewClient(testKey,
+		mailerlite.WithHTTPClient(testClient),
+		mailerlite.WithLogger(logger, mailerlite.WithLogBodies(1024)),
+	)
+
+	subscriber, _, err := client.Subscriber.Get(context.TODO(), &mailerlite.GetSubscriberOptions{Email: "client@example.com"})
+
+	assert.NoError(t, err)
+	assert.Equal(t, "client@example.com", subscriber.Data.Email)
+
+	var record map[string]interface{}
+	assert.NoError(t, json.Unmarshal(buf.Bytes(), &record))
+
+	assert.Equal(t, "GET", record["method"])
+	assert.Equal(t, "/api/subscribers/[REDACTED_EMAIL]", record["path"])
+	assert.Equal(t, float64(http.StatusOK), record["status"])
+	assert.Equal(t, "119", record["rate_limit"].(map[string]interface{})["remaining"])
+	assert.Contains(t, record["response_body"], "[REDACTED_EMAIL]")
+	assert.NotContains(t, buf.String(), "client@example.com")
+	assert.NotContains(t, buf.String(), testKey)
+}
+
+func TestLoggerLogsExpandedFilters(t *testing.T) {
+	testClient := NewTestClient(func(req *http.Request) *http.Response {
+		return &http.Response{
+			StatusCode: http.StatusUnprocessableEntity,
+			Request:    req,
+			Body:       io.NopCloser(strings.NewReader(`{"message": "The given data was invalid."}`)),
+		}
+	})
+
+	buf := new(bytes.Buffer)
+	logger := slog.New(slog.NewJSONHandler(buf, nil))
+
+	client := mailerlite.NewClient(testKey,
+		mailerlite.WithHTTPClient(testClient),
+		mailerlite.WithLogger(logger, mailerlite.WithRedactionRules(mailerlite.RedactJSONFields("name"))),
+	)
+
+	_, _, err := client.Subscriber.List(context.TODO(), &mailerlite.ListSubscriberOptions{
+		Filters: &[]mailerlite.Filter{{Name: "status", Value: "active"}},
+	})
+	assert.Error(t, err)
+
+	var record map[string]interface{}
+	assert.NoError(t, json.Unmarshal(buf.Bytes(), &record))
+
+	assert.Equal(t, "ERROR", record["level"])
+	assert.Equal(t, "filter[status]=active", record["query"])
+	assert.Contains(t, record["error"], "The given data was invalid.")
+	assert.NotContains(t, record, "request_body")
+}
+
+func TestRedactJSONFields(t *testing.T) {
+	testClient := NewTestClient(func(req *http.Request) *http.Response {
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Request:    req,
+			Body:       io.NopCloser(strings.NewReader(`{"data": {}}`)),
+		}
+	})
+
+	buf := new(bytes.Buffer)
+	logger := slog.New(slog.NewJSONHandler(buf, &slog.HandlerOptions{Level: slog.LevelDebug}))
+
+	rules := append(mailerlite.DefaultRedactionRules(), mailerlite.RedactJSONFields("name", "phone"))
+	client := mailerlite.NewClient(testKey,
+		mailerlite.WithHTTPClient(testClient),
+		mailerlite.WithLogger(logger, mailerlite.WithLogBodies(1024), mailerlite.WithRedactionRules(rules...)),
+	)
+
+	_, _, err := client.Subscriber.Upsert(context.TODO(), &mailerlite.UpsertSubscriber{
+		Email:  "client@example.com",
+		Fields: map[string]interface{}{"name": "John", "phone": "+1 555 0100"},
+	})
+	assert.NoError(t, err)
+
+	assert.NotContains(t, buf.String(), "John")
+	assert.NotContains(t, buf.String(), "555")
+	assert.NotContains(t, buf.String(), "client@example.com")
+}
+
+func TestLoggerRedactsBeforeTruncating(t *testing.T) {
+	testClient := NewTestClient(func(req *http.Request) *http.Response {
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Request:    req,
+			Body:       io.NopCloser(strings.NewReader(`{"data": {"email": "client@example.com", "fields": {"name": "Johnathan"}}}`)),
+		}
+	})
+
+	buf := new(bytes.Buffer)
+	logger := slog.New(slog.NewJSONHandler(buf, &slog.HandlerOptions{Level: slog.LevelDebug}))
+
+	rules := append(mailerlite.DefaultRedactionRules(), mailerlite.RedactJSONFields("name"))
+	client := mailerlite.NewClient(testKey,
+		mailerlite.WithHTTPClient(testClient),
+		mailerlite.WithLogger(logger, mailerlite.WithLogBodies(20), mailerlite.WithRedactionRules(rules...)),
+	)
+
+	// The request body is cut within the email, the response body within
+	// the name.
+	_, _, err := client.Subscriber.Upsert(context.TODO(), &mailerlite.UpsertSubscriber{Email: "john.doe@example.com"})
+	assert.NoError(t, err)
+
+	var record map[string]interface{}
+	assert.NoError(t, json.Unmarshal(buf.Bytes(), &record))
+
+	assert.Len(t, record["request_body"], 20)
+	assert.NotContains(t, buf.String(), "john.doe")
+	assert.NotContains(t, buf.String(), "client@")
+	assert.NotContains(t, buf.String(), "John")
+
+	buf.Reset()
+	client = mailerlite.NewClient(testKey,
+		mailerlite.WithHTTPClient(testClient),
+		mailerlite.WithLogger(logger, mailerlite.WithLogBodies(70), mailerlite.WithRedactionRules(rules...)),
+	)
+	_, _, err = client.Subscriber.Upsert(context.TODO(), &mailerlite.UpsertSubscriber{Email: "john.doe@example.com"})
+	assert.NoError(t, err)
+
+	assert.NotContains(t, buf.String(), "Johna")
+}
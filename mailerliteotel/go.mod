@@ -0,0 +1,28 @@
+module github.com/mailerlite/mailerlite-go/mailerliteotel
+
+go 1.25.0
+
+require (
+	github.com/mailerlite/mailerlite-go v0.1.0
+	github.com/stretchr/testify v1.12.1
+	go.opentelemetry.io/otel v1.46.0
+	go.opentelemetry.io/otel/sdk v1.46.0
+	go.opentelemetry.io/otel/trace v1.46.0
+)
+
+require (
+	github.com/cespare/xxhash/v2 v2.3.0 // indirect
+	github.com/go-logr/logr v1.4.4 // indirect
+	github.com/go-logr/stdr v1.2.2 // indirect
+	github.com/google/go-querystring v1.2.0 // indirect
+	github.com/google/uuid v1.6.0 // indirect
+	go.opentelemetry.io/auto/sdk v1.2.1 // indirect
+	go.opentelemetry.io/otel/metric v1.46.0 // indirect
+	go.yaml.in/yaml/v3 v3.0.5 // indirect
+	golang.org/x/sys v0.47.0 // indirect
+)
+
+// The replace directive only applies within this repository. Modules using
+// this one get the required release, v0.1.0 being the first one with
+// Middleware, Doer and Operation, so it has to be tagged before this module.
+replace github.com/mailerlite/mailerlite-go => ../
@@ -0,0 +1,123 @@
+// Package mailerliteotel traces MailerLite API calls with OpenTelemetry.
+//
+// It lives in its own module so that the core SDK stays free of
+// dependencies.
+package mailerliteotel
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/mailerlite/mailerlite-go"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const instrumentationName = "github.com/mailerlite/mailerlite-go/mailerliteotel"
+
+// Attribute keys recorded on every span.
+const (
+	OperationKey     = attribute.Key("mailerlite.operation")
+	RateRemainingKey = attribute.Key("mailerlite.rate_limit.remaining")
+	AttemptsKey      = attribute.Key("mailerlite.attempts")
+
+	httpMethodKey = attribute.Key("http.request.method")
+	httpStatusKey = attribute.Key("http.response.status_code")
+	serverAddrKey = attribute.Key("server.address")
+	errorTypeKey  = attribute.Key("error.type")
+)
+
+// Option configures the tracing middleware.
+type Option func(*config)
+
+type config struct {
+	provider    trace.TracerProvider
+	propagators propagation.TextMapPropagator
+}
+
+// WithTracerProvider sets the provider used to create the tracer, the
+// global provider is used by default.
+func WithTracerProvider(provider trace.TracerProvider) Option {
+	return func(cfg *config) {
+		cfg.provider = provider
+	}
+}
+
+// WithPropagators injects the span context into the headers of every
+// request with the given propagators. Nothing is injected by default.
+func WithPropagators(propagators propagation.TextMapPropagator) Option {
+	return func(cfg *config) {
+		cfg.propagators = propagators
+	}
+}
+
+// WithTracing returns a client option tracing every API call, see Middleware.
+func WithTracing(opts ...Option) mailerlite.ClientOption {
+	return mailerlite.WithMiddleware(Middleware(opts...))
+}
+
+// Middleware returns a mailerlite.Middleware wrapping every API call in a
+// client span named after the service operation, e.g.
+// "mailerlite.Subscriber.Upsert". The span is a child of the span found in
+// the context passed to the service method.
+func Middleware(opts ...Option) mailerlite.Middleware {
+	cfg := &config{provider: otel.GetTracerProvider()}
+	for _, opt := range opts {
+		opt(cfg)
+	}
+
+	tracer := cfg.provider.Tracer(instrumentationName, trace.WithInstrumentationVersion(mailerlite.Version))
+
+	return func(next mailerlite.Doer) mailerlite.Doer {
+		return mailerlite.DoerFunc(func(req *http.Request) (*mailerlite.Response, error) {
+			operation := mailerlite.Operation(req.Context())
+
+			name := "mailerlite.request"
+			if operation != "" {
+				name = "mailerlite." + operation
+			}
+
+			ctx, span := tracer.Start(req.Context(), name,
+				trace.WithSpanKind(trace.SpanKindClient),
+				trace.WithAttributes(
+					OperationKey.String(operation),
+					httpMethodKey.String(req.Method),
+					serverAddrKey.String(req.URL.Hostname()),
+				),
+			)
+			defer span.End()
+
+			req = req.WithContext(ctx)
+			if cfg.propagators != nil {
+				cfg.propagators.Inject(ctx, propagation.HeaderCarrier(req.Header))
+			}
+
+			res, err := next.Do(req)
+
+			if res != nil && res.Response != nil {
+				span.SetAttributes(
+					httpStatusKey.Int(res.StatusCode),
+					RateRemainingKey.Int(res.Rate.Remaining),
+					AttemptsKey.Int(res.Attempts),
+				)
+			}
+
+			// The error message is not recorded, it contains the request URL
+			// which may hold subscriber emails.
+			if err != nil {
+				span.SetAttributes(errorTypeKey.String(errorType(err)))
+				span.SetStatus(codes.Error, errorType(err))
+			}
+
+			return res, err
+		})
+	}
+}
+
+// errorType returns the type of err, e.g. "*mailerlite.RateLimitError".
+func errorType(err error) string {
+	return fmt.Sprintf("%T", err)
+}
@@ -0,0 +1,109 @@
+package mailerliteotel_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/mailerlite/mailerlite-go"
+	"github.com/mailerlite/mailerlite-go/mailerliteotel"
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type RoundTripFunc func(req *http.Request) *http.Response
+
+func (f RoundTripFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req), nil
+}
+
+func attributes(span sdktrace.ReadOnlySpan) map[attribute.Key]attribute.Value {
+	attrs := make(map[attribute.Key]attribute.Value)
+	for _, kv := range span.Attributes() {
+		attrs[kv.Key] = kv.Value
+	}
+	return attrs
+}
+
+func TestSpanIsNamedAfterOperation(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+
+	testClient := &http.Client{Transport: RoundTripFunc(func(req *http.Request) *http.Response {
+		assert.NotEmpty(t, req.Header.Get("traceparent"))
+
+		header := http.Header{}
+		header.Set(mailerlite.HeaderRateLimit, "120")
+		header.Set(mailerlite.HeaderRateRemaining, "42")
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Request:    req,
+			Header:     header,
+			Body:       io.NopCloser(strings.NewReader(`{"data": {}}`)),
+		}
+	})}
+
+	client := mailerlite.NewClient("valid-api-key",
+		mailerlite.WithHTTPClient(testClient),
+		mailerliteotel.WithTracing(
+			mailerliteotel.WithTracerProvider(provider),
+			mailerliteotel.WithPropagators(propagation.TraceContext{}),
+		),
+	)
+
+	ctx, parent := provider.Tracer("test").Start(context.Background(), "parent")
+	_, _, err := client.Subscriber.Upsert(ctx, &mailerlite.UpsertSubscriber{Email: "example@example.com"})
+	parent.End()
+
+	assert.NoError(t, err)
+
+	spans := recorder.Ended()
+	assert.Equal(t, 2, len(spans))
+
+	span := spans[0]
+	assert.Equal(t, "mailerlite.Subscriber.Upsert", span.Name())
+	assert.Equal(t, trace.SpanKindClient, span.SpanKind())
+	assert.Equal(t, parent.SpanContext().SpanID(), span.Parent().SpanID())
+
+	attrs := attributes(span)
+	assert.Equal(t, "Subscriber.Upsert", attrs[mailerliteotel.OperationKey].AsString())
+	assert.Equal(t, int64(42), attrs[mailerliteotel.RateRemainingKey].AsInt64())
+	assert.Equal(t, int64(1), attrs[mailerliteotel.AttemptsKey].AsInt64())
+	assert.Equal(t, int64(http.StatusOK), attrs["http.response.status_code"].AsInt64())
+}
+
+func TestSpanRecordsErrorType(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+
+	testClient := &http.Client{Transport: RoundTripFunc(func(req *http.Request) *http.Response {
+		return &http.Response{
+			StatusCode: http.StatusUnauthorized,
+			Request:    req,
+			Body:       io.NopCloser(strings.NewReader(`{"message": "Unauthenticated."}`)),
+		}
+	})}
+
+	client := mailerlite.NewClient("invalid-api-key",
+		mailerlite.WithHTTPClient(testClient),
+		mailerliteotel.WithTracing(mailerliteotel.WithTracerProvider(provider)),
+	)
+
+	_, _, err := client.Group.List(context.Background(), nil)
+	assert.Error(t, err)
+
+	spans := recorder.Ended()
+	assert.Equal(t, 1, len(spans))
+
+	span := spans[0]
+	assert.Equal(t, "mailerlite.Group.List", span.Name())
+	assert.Equal(t, codes.Error, span.Status().Code)
+	assert.Equal(t, "*mailerlite.AuthError", attributes(span)["error.type"].AsString())
+}
@@ -0,0 +1,156 @@
+// Package mailerliteprom exports MailerLite API call metrics to Prometheus.
+//
+// It lives in its own module so that the core SDK stays free of
+// dependencies.
+package mailerliteprom
+
+import (
+	"strconv"
+
+	"github.com/mailerlite/mailerlite-go"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// Collector implements mailerlite.Metrics and prometheus.Collector. Register
+// it with a prometheus.Registerer and pass it to mailerlite.WithMetrics.
+type Collector struct {
+	requests      *prometheus.CounterVec
+	duration      *prometheus.HistogramVec
+	errors        *prometheus.CounterVec
+	retries       *prometheus.CounterVec
+	rateLimit     prometheus.Gauge
+	rateRemaining prometheus.Gauge
+}
+
+// Option configures a Collector.
+type Option func(*options)
+
+type options struct {
+	namespace   string
+	constLabels prometheus.Labels
+	buckets     []float64
+}
+
+// WithNamespace sets the namespace of the metrics, "mailerlite" by default.
+func WithNamespace(namespace string) Option {
+	return func(o *options) {
+		o.namespace = namespace
+	}
+}
+
+// WithConstLabels adds constant labels to every metric, e.g. the account name.
+func WithConstLabels(labels prometheus.Labels) Option {
+	return func(o *options) {
+		o.constLabels = labels
+	}
+}
+
+// WithBuckets sets the buckets of the request duration histogram.
+func WithBuckets(buckets []float64) Option {
+	return func(o *options) {
+		o.buckets = buckets
+	}
+}
+
+// NewCollector returns a Collector exposing:
+//
+//   - mailerlite_requests_total{operation,method,status}
+//   - mailerlite_request_duration_seconds{operation}
+//   - mailerlite_errors_total{operation,class}
+//   - mailerlite_retries_total{operation}
+//   - mailerlite_rate_limit
+//   - mailerlite_rate_limit_remaining
+func NewCollector(opts ...Option) *Collector {
+	o := &options{
+		namespace: "mailerlite",
+		buckets:   prometheus.DefBuckets,
+	}
+	for _, opt := range opts {
+		opt(o)
+	}
+
+	return &Collector{
+		requests: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace:   o.namespace,
+			Name:        "requests_total",
+			Help:        "Number of MailerLite API calls.",
+			ConstLabels: o.constLabels,
+		}, []string{"operation", "method", "status"}),
+		duration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace:   o.namespace,
+			Name:        "request_duration_seconds",
+			Help:        "Duration of MailerLite API calls, including retries.",
+			ConstLabels: o.constLabels,
+			Buckets:     o.buckets,
+		}, []string{"operation"}),
+		errors: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace:   o.namespace,
+			Name:        "errors_total",
+			Help:        "Number of failed MailerLite API calls by error class.",
+			ConstLabels: o.constLabels,
+		}, []string{"operation", "class"}),
+		retries: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace:   o.namespace,
+			Name:        "retries_total",
+			Help:        "Number of retried MailerLite API requests.",
+			ConstLabels: o.constLabels,
+		}, []string{"operation"}),
+		rateLimit: prometheus.NewGauge(prometheus.GaugeOpts{
+			Namespace:   o.namespace,
+			Name:        "rate_limit",
+			Help:        "Last observed X-RateLimit-Limit.",
+			ConstLabels: o.constLabels,
+		}),
+		rateRemaining: prometheus.NewGauge(prometheus.GaugeOpts{
+			Namespace:   o.namespace,
+			Name:        "rate_limit_remaining",
+			Help:        "Last observed X-RateLimit-Remaining.",
+			ConstLabels: o.constLabels,
+		}),
+	}
+}
+
+// ObserveRequest implements mailerlite.Metrics.
+func (c *Collector) ObserveRequest(m mailerlite.RequestMetrics) {
+	status := "none"
+	if m.Status != 0 {
+		status = strconv.Itoa(m.Status)
+	}
+
+	c.requests.WithLabelValues(m.Operation, m.Method, status).Inc()
+	c.duration.WithLabelValues(m.Operation).Observe(m.Duration.Seconds())
+
+	if m.Err != nil {
+		c.errors.WithLabelValues(m.Operation, mailerlite.ErrorClass(m.Err)).Inc()
+	}
+	if m.Attempts > 1 {
+		c.retries.WithLabelValues(m.Operation).Add(float64(m.Attempts - 1))
+	}
+
+	// Responses without rate limit headers, e.g. network errors, leave the
+	// gauges untouched.
+	if m.Rate.Limit > 0 {
+		c.rateLimit.Set(float64(m.Rate.Limit))
+		c.rateRemaining.Set(float64(m.Rate.Remaining))
+	}
+}
+
+// Describe implements prometheus.Collector.
+func (c *Collector) Describe(ch chan<- *prometheus.Desc) {
+	c.requests.Describe(ch)
+	c.duration.Describe(ch)
+	c.errors.Describe(ch)
+	c.retries.Describe(ch)
+	c.rateLimit.Describe(ch)
+	c.rateRemaining.Describe(ch)
+}
+
+// Collect implements prometheus.Collector.
+func (c *Collector) Collect(ch chan<- prometheus.Metric) {
+	c.requests.Collect(ch)
+	c.duration.Collect(ch)
+	c.errors.Collect(ch)
+	c.retries.Collect(ch)
+	c.rateLimit.Collect(ch)
+	c.rateRemaining.Collect(ch)
+}
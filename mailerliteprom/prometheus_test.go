@@ -0,0 +1,86 @@
+package mailerliteprom_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/mailerlite/mailerlite-go"
+	"github.com/mailerlite/mailerlite-go/mailerliteprom"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+)
+
+type RoundTripFunc func(req *http.Request) *http.Response
+
+func (f RoundTripFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req), nil
+}
+
+func TestCollectorObservesRequests(t *testing.T) {
+	calls := 0
+	testClient := &http.Client{Transport: RoundTripFunc(func(req *http.Request) *http.Response {
+		calls++
+		header := http.Header{}
+		header.Set(mailerlite.HeaderRateLimit, "120")
+		header.Set(mailerlite.HeaderRateRemaining, strconv.Itoa(120-calls))
+		if calls == 2 {
+			header.Set(mailerlite.HeaderRateRemaining, "0")
+			header.Set(mailerlite.HeaderRateRetryAfter, "0")
+			return &http.Response{
+				StatusCode: http.StatusTooManyRequests,
+				Request:    req,
+				Header:     header,
+				Body:       io.NopCloser(strings.NewReader(`{"message": "Too Many Attempts."}`)),
+			}
+		}
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Request:    req,
+			Header:     header,
+			Body:       io.NopCloser(strings.NewReader(`{"data": []}`)),
+		}
+	})}
+
+	collector := mailerliteprom.NewCollector()
+	registry := prometheus.NewPedanticRegistry()
+	assert.NoError(t, registry.Register(collector))
+
+	client := mailerlite.NewClient("valid-api-key",
+		mailerlite.WithHTTPClient(testClient),
+		mailerlite.WithMetrics(collector),
+	)
+
+	_, _, err := client.Group.List(context.Background(), nil)
+	assert.NoError(t, err)
+
+	_, _, err = client.Group.List(context.Background(), nil)
+	assert.Error(t, err)
+
+	expected := `
+# HELP mailerlite_errors_total Number of failed MailerLite API calls by error class.
+# TYPE mailerlite_errors_total counter
+mailerlite_errors_total{class="RateLimitError",operation="Group.List"} 1
+# HELP mailerlite_rate_limit Last observed X-RateLimit-Limit.
+# TYPE mailerlite_rate_limit gauge
+mailerlite_rate_limit 120
+# HELP mailerlite_rate_limit_remaining Last observed X-RateLimit-Remaining.
+# TYPE mailerlite_rate_limit_remaining gauge
+mailerlite_rate_limit_remaining 0
+# HELP mailerlite_requests_total Number of MailerLite API calls.
+# TYPE mailerlite_requests_total counter
+mailerlite_requests_total{method="GET",operation="Group.List",status="200"} 1
+mailerlite_requests_total{method="GET",operation="Group.List",status="429"} 1
+`
+	assert.NoError(t, testutil.GatherAndCompare(registry, strings.NewReader(expected),
+		"mailerlite_errors_total",
+		"mailerlite_rate_limit",
+		"mailerlite_rate_limit_remaining",
+		"mailerlite_requests_total",
+	))
+	assert.Equal(t, 1, testutil.CollectAndCount(collector, "mailerlite_request_duration_seconds"))
+}
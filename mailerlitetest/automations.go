@@ -0,0 +1,104 @@
+package mailerlitetest
+
+import (
+	"net/http"
+
+	"github.com/mailerlite/mailerlite-go"
+)
+
+func (s *Server) routeAutomations(w http.ResponseWriter, r *http.Request, parts []string) {
+	switch {
+	case len(parts) == 0 && r.Method == http.MethodGet:
+		s.listAutomations(w, r)
+	case len(parts) == 0 && r.Method == http.MethodPost:
+		s.createAutomation(w, r)
+	case len(parts) == 1 && r.Method == http.MethodGet:
+		s.getAutomation(w, parts[0])
+	case len(parts) == 1 && r.Method == http.MethodDelete:
+		s.deleteAutomation(w, parts[0])
+	case len(parts) == 2 && parts[1] == "activity" && r.Method == http.MethodGet:
+		s.automationActivity(w, r, parts[0])
+	default:
+		writeNotFound(w)
+	}
+}
+
+func (s *Server) findAutomation(automationID string) *mailerlite.Automation {
+	for _, a := range s.automations {
+		if a.ID == automationID {
+			return a
+		}
+	}
+	return nil
+}
+
+func (s *Server) listAutomations(w http.ResponseWriter, r *http.Request) {
+	enabled, name := filter(r, "enabled"), filter(r, "name")
+
+	automations := make([]mailerlite.Automation, 0, len(s.automations))
+	for _, a := range s.automations {
+		if enabled != "" && (enabled == "true") != a.Enabled {
+			continue
+		}
+		if name != "" && a.Name != name {
+			continue
+		}
+		automations = append(automations, *a)
+	}
+
+	p := s.paginate(r, len(automations))
+	writeJSON(w, http.StatusOK, mailerlite.RootAutomations{Data: automations[p.start:p.end], Links: p.links, Meta: p.meta})
+}
+
+func (s *Server) getAutomation(w http.ResponseWriter, automationID string) {
+	a := s.findAutomation(automationID)
+	if a == nil {
+		writeNotFound(w)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, mailerlite.RootAutomation{Data: *a})
+}
+
+func (s *Server) createAutomation(w http.ResponseWriter, r *http.Request) {
+	var body groupBody
+	if !decodeBody(w, r, &body) || validateGroup(w, body) {
+		return
+	}
+
+	a := &mailerlite.Automation{
+		ID:        s.newID(),
+		Name:      body.Name,
+		Steps:     []mailerlite.Step{},
+		Triggers:  []mailerlite.Triggers{},
+		Warnings:  []interface{}{},
+		CreatedAt: now(),
+	}
+	s.automations = append(s.automations, a)
+
+	writeJSON(w, http.StatusCreated, mailerlite.RootAutomation{Data: *a})
+}
+
+func (s *Server) deleteAutomation(w http.ResponseWriter, automationID string) {
+	for i, a := range s.automations {
+		if a.ID == automationID {
+			s.automations = append(s.automations[:i], s.automations[i+1:]...)
+			writeJSON(w, http.StatusNoContent, nil)
+			return
+		}
+	}
+
+	writeNotFound(w)
+}
+
+// automationActivity always returns an empty list, automations never run on
+// the fake server.
+func (s *Server) automationActivity(w http.ResponseWriter, r *http.Request, automationID string) {
+	if s.findAutomation(automationID) == nil {
+		writeNotFound(w)
+		return
+	}
+
+	p := s.paginate(r, 0)
+	writeJSON(w, http.StatusOK, mailerlite.RootAutomationsSubscriber{Data: []mailerlite.AutomationSubscriber{}, Links: p.links, Meta: p.meta})
+}
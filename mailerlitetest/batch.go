@@ -0,0 +1,63 @@
+package mailerlitetest
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+
+	"github.com/mailerlite/mailerlite-go"
+)
+
+// routeBatch runs the requests of a batch one after the other, as if they
+// were sent on their own.
+func (s *Server) routeBatch(w http.ResponseWriter, r *http.Request, parts []string) {
+	if len(parts) != 0 || r.Method != http.MethodPost {
+		writeNotFound(w)
+		return
+	}
+
+	var body struct {
+		Requests []struct {
+			Method string          `json:"method"`
+			Path   string          `json:"path"`
+			Body   json.RawMessage `json:"body"`
+		} `json:"requests"`
+	}
+	if !decodeBody(w, r, &body) {
+		return
+	}
+
+	errs := validationErrors{}
+	if len(body.Requests) == 0 {
+		errs.add("requests", "The requests field is required.")
+	} else if len(body.Requests) > mailerlite.MaxBatchSize {
+		errs.add("requests", "The requests may not have more than %d items.", mailerlite.MaxBatchSize)
+	}
+	if errs.write(w) {
+		return
+	}
+
+	root := mailerlite.RootBatch{Total: len(body.Requests), Responses: []mailerlite.BatchResponse{}}
+	for _, batched := range body.Requests {
+		path := "/" + strings.TrimPrefix(strings.TrimPrefix(batched.Path, "/"), strings.TrimPrefix(apiPath, "/")+"/")
+
+		req := httptest.NewRequest(strings.ToUpper(batched.Method), path, bytes.NewReader(batched.Body))
+		rec := httptest.NewRecorder()
+		s.route(rec, req, strings.Split(strings.Trim(path, "/"), "/"))
+
+		if rec.Code < http.StatusBadRequest {
+			root.Successful++
+		} else {
+			root.Failed++
+		}
+		res := mailerlite.BatchResponse{Code: rec.Code}
+		if b := bytes.TrimSpace(rec.Body.Bytes()); len(b) > 0 {
+			res.Body = b
+		}
+		root.Responses = append(root.Responses, res)
+	}
+
+	writeJSON(w, http.StatusOK, root)
+}
@@ -0,0 +1,322 @@
+package mailerlitetest
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/mailerlite/mailerlite-go"
+)
+
+var campaignTypes = map[string]bool{
+	mailerlite.CampaignTypeRegular: true,
+	mailerlite.CampaignTypeAB:      true,
+	mailerlite.CampaignTypeResend:  true,
+}
+
+var deliveryTypes = map[string]bool{
+	mailerlite.CampaignScheduleTypeInstant:   true,
+	mailerlite.CampaignScheduleTypeScheduled: true,
+	mailerlite.CampaignScheduleTypeTimezone:  true,
+}
+
+var languages = []mailerlite.CampaignLanguage{
+	{Id: "1", Shortcode: "en", Iso639: "en-US", Name: "English", Direction: "ltr"},
+	{Id: "4", Shortcode: "de", Iso639: "de-DE", Name: "German", Direction: "ltr"},
+	{Id: "8", Shortcode: "fr", Iso639: "fr-FR", Name: "French", Direction: "ltr"},
+	{Id: "21", Shortcode: "lt", Iso639: "lt-LT", Name: "Lithuanian", Direction: "ltr"},
+}
+
+func (s *Server) routeCampaigns(w http.ResponseWriter, r *http.Request, parts []string) {
+	switch {
+	case len(parts) == 0 && r.Method == http.MethodGet:
+		s.listCampaigns(w, r)
+	case len(parts) == 0 && r.Method == http.MethodPost:
+		s.createCampaign(w, r)
+	case len(parts) == 1 && parts[0] == "languages" && r.Method == http.MethodGet:
+		writeJSON(w, http.StatusOK, mailerlite.RootCampaignLanguages{Data: languages})
+	case len(parts) == 1 && r.Method == http.MethodGet:
+		s.getCampaign(w, parts[0])
+	case len(parts) == 1 && r.Method == http.MethodPut:
+		s.updateCampaign(w, r, parts[0])
+	case len(parts) == 1 && r.Method == http.MethodDelete:
+		s.deleteCampaign(w, parts[0])
+	case len(parts) == 2 && parts[1] == "schedule" && r.Method == http.MethodPost:
+		s.scheduleCampaign(w, r, parts[0])
+	case len(parts) == 2 && parts[1] == "cancel" && r.Method == http.MethodPost:
+		s.cancelCampaign(w, parts[0])
+	case len(parts) == 3 && parts[1] == "reports" && parts[2] == "subscriber-activity" && r.Method == http.MethodGet:
+		s.campaignSubscribers(w, r, parts[0])
+	default:
+		writeNotFound(w)
+	}
+}
+
+func (s *Server) findCampaign(campaignID string) *campaign {
+	for _, c := range s.campaigns {
+		if c.ID == campaignID {
+			return c
+		}
+	}
+	return nil
+}
+
+// recipients returns the subscribers a campaign was sent to, the members of
+// its groups and segments.
+func (s *Server) recipients(c *campaign) []*mailerlite.Subscriber {
+	if c.Status != "sent" {
+		return nil
+	}
+
+	seen := make(map[string]bool)
+	var recipients []*mailerlite.Subscriber
+	add := func(subs []*mailerlite.Subscriber) {
+		for _, sub := range subs {
+			if !seen[sub.ID] {
+				seen[sub.ID] = true
+				recipients = append(recipients, sub)
+			}
+		}
+	}
+
+	for _, groupID := range c.groups {
+		add(s.members(groupID))
+	}
+	for _, segmentID := range c.segments {
+		if seg := s.findSegment(segmentID); seg != nil {
+			add(s.segmentMembers(seg))
+		}
+	}
+
+	return recipients
+}
+
+func (s *Server) renderCampaign(c *campaign) mailerlite.Campaign {
+	rendered := c.Campaign
+	rendered.Stats.Sent = len(s.recipients(c))
+	return rendered
+}
+
+func (s *Server) listCampaigns(w http.ResponseWriter, r *http.Request) {
+	status, typ := filter(r, "status"), filter(r, "type")
+
+	campaigns := make([]mailerlite.Campaign, 0, len(s.campaigns))
+	for _, c := range s.campaigns {
+		if (status == "" || c.Status == status) && (typ == "" || c.Type == typ) {
+			campaigns = append(campaigns, s.renderCampaign(c))
+		}
+	}
+
+	p := s.paginate(r, len(campaigns))
+	writeJSON(w, http.StatusOK, mailerlite.RootCampaigns{Data: campaigns[p.start:p.end], Links: p.links, Meta: p.meta})
+}
+
+func (s *Server) getCampaign(w http.ResponseWriter, campaignID string) {
+	c := s.findCampaign(campaignID)
+	if c == nil {
+		writeNotFound(w)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, mailerlite.RootCampaign{Data: s.renderCampaign(c)})
+}
+
+func (s *Server) validateCampaign(w http.ResponseWriter, body *mailerlite.CreateCampaign) bool {
+	errs := validationErrors{}
+	if body.Name == "" {
+		errs.add("name", "The name field is required.")
+	}
+	if !campaignTypes[body.Type] {
+		errs.add("type", "The selected type is invalid.")
+	}
+	if len(body.Emails) == 0 {
+		errs.add("emails", "The emails field is required.")
+	}
+	for i, email := range body.Emails {
+		if email.Subject == "" {
+			errs.add(fmt.Sprintf("emails.%d.subject", i), "The emails.%d.subject field is required.", i)
+		}
+		if email.FromName == "" {
+			errs.add(fmt.Sprintf("emails.%d.from_name", i), "The emails.%d.from_name field is required.", i)
+		}
+		if !validEmail(email.From) {
+			errs.add(fmt.Sprintf("emails.%d.from", i), "The emails.%d.from must be a valid email address.", i)
+		}
+	}
+	for _, groupID := range body.Groups {
+		if s.findGroup(groupID) == nil {
+			errs.add("groups", "The selected groups is invalid.")
+			break
+		}
+	}
+	for _, segmentID := range body.Segments {
+		if s.findSegment(segmentID) == nil {
+			errs.add("segments", "The selected segments is invalid.")
+			break
+		}
+	}
+	return errs.write(w)
+}
+
+func (s *Server) applyCampaign(c *campaign, body *mailerlite.CreateCampaign) {
+	c.Name = body.Name
+	c.Type = body.Type
+	c.TypeForHumans = body.Type
+	c.UpdatedAt = now()
+	c.groups = body.Groups
+	c.segments = body.Segments
+
+	c.Emails = make([]mailerlite.Email, len(body.Emails))
+	for i, email := range body.Emails {
+		c.Emails[i] = mailerlite.Email{
+			ID:            s.newID(),
+			EmailableID:   c.ID,
+			EmailableType: "campaigns",
+			Type:          "builder",
+			From:          email.From,
+			FromName:      email.FromName,
+			Subject:       email.Subject,
+			CreatedAt:     c.CreatedAt,
+			UpdatedAt:     c.UpdatedAt,
+		}
+	}
+	if len(c.Emails) > 0 {
+		c.DefaultEmailID = c.Emails[0].ID
+	}
+}
+
+func (s *Server) createCampaign(w http.ResponseWriter, r *http.Request) {
+	var body mailerlite.CreateCampaign
+	if !decodeBody(w, r, &body) || s.validateCampaign(w, &body) {
+		return
+	}
+
+	c := &campaign{Campaign: mailerlite.Campaign{
+		ID:             s.newID(),
+		Status:         "draft",
+		CreatedAt:      now(),
+		CanBeScheduled: true,
+		MissingData:    []interface{}{},
+		Warnings:       []interface{}{},
+	}}
+	s.applyCampaign(c, &body)
+	s.campaigns = append(s.campaigns, c)
+
+	writeJSON(w, http.StatusCreated, mailerlite.RootCampaign{Data: s.renderCampaign(c)})
+}
+
+func (s *Server) updateCampaign(w http.ResponseWriter, r *http.Request, campaignID string) {
+	c := s.findCampaign(campaignID)
+	if c == nil {
+		writeNotFound(w)
+		return
+	}
+
+	var body mailerlite.CreateCampaign
+	if !decodeBody(w, r, &body) || s.validateCampaign(w, &body) {
+		return
+	}
+	if c.Status != "draft" {
+		writeError(w, http.StatusUnprocessableEntity, "Only draft campaigns can be updated.", nil)
+		return
+	}
+	s.applyCampaign(c, &body)
+
+	writeJSON(w, http.StatusOK, mailerlite.RootCampaign{Data: s.renderCampaign(c)})
+}
+
+// scheduleCampaign sends instant campaigns right away and marks the others
+// as ready.
+func (s *Server) scheduleCampaign(w http.ResponseWriter, r *http.Request, campaignID string) {
+	c := s.findCampaign(campaignID)
+	if c == nil {
+		writeNotFound(w)
+		return
+	}
+
+	var body mailerlite.ScheduleCampaign
+	if !decodeBody(w, r, &body) {
+		return
+	}
+
+	errs := validationErrors{}
+	if !deliveryTypes[body.Delivery] {
+		errs.add("delivery", "The selected delivery is invalid.")
+	} else if body.Delivery != mailerlite.CampaignScheduleTypeInstant && body.Schedule == nil {
+		errs.add("schedule", "The schedule field is required.")
+	}
+	if errs.write(w) {
+		return
+	}
+	if c.Status != "draft" {
+		writeError(w, http.StatusUnprocessableEntity, "Only draft campaigns can be scheduled.", nil)
+		return
+	}
+
+	c.DeliverySchedule = body.Delivery
+	c.UpdatedAt = now()
+	if body.Delivery == mailerlite.CampaignScheduleTypeInstant {
+		c.Status = "sent"
+		c.QueuedAt, c.StartedAt, c.FinishedAt = now(), now(), now()
+	} else {
+		c.Status = "ready"
+		date, _ := time.Parse("2006-01-02", body.Schedule.Date)
+		hours, _ := strconv.Atoi(body.Schedule.Hours)
+		minutes, _ := strconv.Atoi(body.Schedule.Minutes)
+		c.ScheduledFor = mailerlite.NewTimestamp(date.Add(time.Duration(hours)*time.Hour + time.Duration(minutes)*time.Minute))
+	}
+
+	writeJSON(w, http.StatusOK, mailerlite.RootCampaign{Data: s.renderCampaign(c)})
+}
+
+func (s *Server) cancelCampaign(w http.ResponseWriter, campaignID string) {
+	c := s.findCampaign(campaignID)
+	if c == nil {
+		writeNotFound(w)
+		return
+	}
+
+	if c.Status != "ready" {
+		writeError(w, http.StatusUnprocessableEntity, "Only scheduled campaigns can be canceled.", nil)
+		return
+	}
+	c.Status = "draft"
+	c.ScheduledFor = mailerlite.Timestamp{}
+	c.UpdatedAt = now()
+
+	writeJSON(w, http.StatusOK, mailerlite.RootCampaign{Data: s.renderCampaign(c)})
+}
+
+func (s *Server) deleteCampaign(w http.ResponseWriter, campaignID string) {
+	for i, c := range s.campaigns {
+		if c.ID == campaignID {
+			s.campaigns = append(s.campaigns[:i], s.campaigns[i+1:]...)
+			writeJSON(w, http.StatusNoContent, nil)
+			return
+		}
+	}
+
+	writeNotFound(w)
+}
+
+func (s *Server) campaignSubscribers(w http.ResponseWriter, r *http.Request, campaignID string) {
+	c := s.findCampaign(campaignID)
+	if c == nil {
+		writeNotFound(w)
+		return
+	}
+
+	recipients := s.recipients(c)
+
+	p := s.paginate(r, len(recipients))
+	root := mailerlite.RootCampaignSubscribers{Data: []mailerlite.CampaignSubscriber{}, Links: p.links, Meta: p.meta}
+	for _, sub := range recipients[p.start:p.end] {
+		root.Data = append(root.Data, mailerlite.CampaignSubscriber{
+			ID:         c.ID + "-" + sub.ID,
+			Subscriber: s.renderSubscriber(sub),
+		})
+	}
+
+	writeJSON(w, http.StatusOK, root)
+}
@@ -0,0 +1,138 @@
+package mailerlitetest
+
+import (
+	"net/http"
+	"regexp"
+	"strings"
+
+	"github.com/mailerlite/mailerlite-go"
+)
+
+var fieldTypes = map[string]bool{"text": true, "number": true, "date": true}
+
+var nonAlphanumeric = regexp.MustCompile(`[^a-z0-9]+`)
+
+func (s *Server) routeFields(w http.ResponseWriter, r *http.Request, parts []string) {
+	switch {
+	case len(parts) == 0 && r.Method == http.MethodGet:
+		s.listFields(w, r)
+	case len(parts) == 0 && r.Method == http.MethodPost:
+		s.createField(w, r)
+	case len(parts) == 1 && r.Method == http.MethodPut:
+		s.updateField(w, r, parts[0])
+	case len(parts) == 1 && r.Method == http.MethodDelete:
+		s.deleteField(w, parts[0])
+	default:
+		writeNotFound(w)
+	}
+}
+
+func (s *Server) findField(fieldID string) *mailerlite.Field {
+	for _, f := range s.fields {
+		if f.Id == fieldID {
+			return f
+		}
+	}
+	return nil
+}
+
+func (s *Server) findFieldByKey(key string) *mailerlite.Field {
+	for _, f := range s.fields {
+		if f.Key == key {
+			return f
+		}
+	}
+	return nil
+}
+
+func (s *Server) listFields(w http.ResponseWriter, r *http.Request) {
+	fields := make([]mailerlite.Field, 0, len(s.fields))
+	keyword := strings.ToLower(filter(r, "keyword"))
+	typ := filter(r, "type")
+	for _, f := range s.fields {
+		if keyword != "" && !strings.Contains(strings.ToLower(f.Name), keyword) {
+			continue
+		}
+		if typ != "" && f.Type != typ {
+			continue
+		}
+		fields = append(fields, *f)
+	}
+
+	p := s.paginate(r, len(fields))
+	writeJSON(w, http.StatusOK, mailerlite.RootFields{Data: fields[p.start:p.end], Links: p.links, Meta: p.meta})
+}
+
+func (s *Server) createField(w http.ResponseWriter, r *http.Request) {
+	var body struct {
+		Name string `json:"name"`
+		Type string `json:"type"`
+	}
+	if !decodeBody(w, r, &body) {
+		return
+	}
+
+	key := strings.Trim(nonAlphanumeric.ReplaceAllString(strings.ToLower(body.Name), "_"), "_")
+
+	errs := validationErrors{}
+	if body.Name == "" {
+		errs.add("name", "The name field is required.")
+	} else if s.findFieldByKey(key) != nil {
+		errs.add("name", "The name has already been taken.")
+	}
+	if !fieldTypes[body.Type] {
+		errs.add("type", "The selected type is invalid.")
+	}
+	if errs.write(w) {
+		return
+	}
+
+	f := &mailerlite.Field{Id: s.newID(), Name: body.Name, Key: key, Type: body.Type}
+	s.fields = append(s.fields, f)
+	for _, sub := range s.subscribers {
+		sub.Fields[key] = nil
+	}
+
+	writeJSON(w, http.StatusCreated, mailerlite.RootField{Data: *f})
+}
+
+func (s *Server) updateField(w http.ResponseWriter, r *http.Request, fieldID string) {
+	f := s.findField(fieldID)
+	if f == nil {
+		writeNotFound(w)
+		return
+	}
+
+	var body struct {
+		Name string `json:"name"`
+	}
+	if !decodeBody(w, r, &body) {
+		return
+	}
+
+	errs := validationErrors{}
+	if body.Name == "" {
+		errs.add("name", "The name field is required.")
+	}
+	if errs.write(w) {
+		return
+	}
+	f.Name = body.Name
+
+	writeJSON(w, http.StatusOK, mailerlite.RootField{Data: *f})
+}
+
+func (s *Server) deleteField(w http.ResponseWriter, fieldID string) {
+	for i, f := range s.fields {
+		if f.Id == fieldID {
+			s.fields = append(s.fields[:i], s.fields[i+1:]...)
+			for _, sub := range s.subscribers {
+				delete(sub.Fields, f.Key)
+			}
+			writeJSON(w, http.StatusNoContent, nil)
+			return
+		}
+	}
+
+	writeNotFound(w)
+}
@@ -0,0 +1,146 @@
+package mailerlitetest
+
+import (
+	"net/http"
+
+	"github.com/mailerlite/mailerlite-go"
+)
+
+var formTypes = map[string]bool{
+	mailerlite.FormTypePopup:     true,
+	mailerlite.FormTypeEmbedded:  true,
+	mailerlite.FormTypePromotion: true,
+}
+
+// AddForm stores a form of the given type with the given subscribers, forms
+// can not be created through the API.
+func (s *Server) AddForm(formType, name string, subscriberIDs ...string) mailerlite.Form {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	f := &form{
+		Form: mailerlite.Form{
+			Id:        s.newID(),
+			Type:      formType,
+			Name:      name,
+			Slug:      name,
+			CreatedAt: now(),
+			Active:    true,
+			Settings:  map[string]interface{}{},
+		},
+		members: subscriberIDs,
+	}
+	s.forms = append(s.forms, f)
+
+	return s.renderForm(f)
+}
+
+func (s *Server) routeForms(w http.ResponseWriter, r *http.Request, parts []string) {
+	switch {
+	case len(parts) == 1 && formTypes[parts[0]] && r.Method == http.MethodGet:
+		s.listForms(w, r, parts[0])
+	case len(parts) == 1 && r.Method == http.MethodGet:
+		s.getForm(w, parts[0])
+	case len(parts) == 1 && r.Method == http.MethodPut:
+		s.updateForm(w, r, parts[0])
+	case len(parts) == 1 && r.Method == http.MethodDelete:
+		s.deleteForm(w, parts[0])
+	case len(parts) == 2 && parts[1] == "subscribers" && r.Method == http.MethodGet:
+		s.formSubscribers(w, r, parts[0])
+	default:
+		writeNotFound(w)
+	}
+}
+
+func (s *Server) findForm(formID string) *form {
+	for _, f := range s.forms {
+		if f.Id == formID {
+			return f
+		}
+	}
+	return nil
+}
+
+func (s *Server) formMembers(f *form) []*mailerlite.Subscriber {
+	var members []*mailerlite.Subscriber
+	for _, id := range f.members {
+		if sub := s.findSubscriber(id); sub != nil {
+			members = append(members, sub)
+		}
+	}
+	return members
+}
+
+func (s *Server) renderForm(f *form) mailerlite.Form {
+	rendered := f.Form
+	rendered.ConversionsCount = len(s.formMembers(f))
+	return rendered
+}
+
+func (s *Server) listForms(w http.ResponseWriter, r *http.Request, formType string) {
+	forms := make([]mailerlite.Form, 0, len(s.forms))
+	for _, f := range s.forms {
+		if f.Type == formType {
+			forms = append(forms, s.renderForm(f))
+		}
+	}
+
+	p := s.paginate(r, len(forms))
+	writeJSON(w, http.StatusOK, mailerlite.RootForms{Data: forms[p.start:p.end], Links: p.links, Meta: p.meta})
+}
+
+func (s *Server) getForm(w http.ResponseWriter, formID string) {
+	f := s.findForm(formID)
+	if f == nil {
+		writeNotFound(w)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, mailerlite.RootForm{Data: s.renderForm(f)})
+}
+
+func (s *Server) updateForm(w http.ResponseWriter, r *http.Request, formID string) {
+	f := s.findForm(formID)
+	if f == nil {
+		writeNotFound(w)
+		return
+	}
+
+	var body groupBody
+	if !decodeBody(w, r, &body) || validateGroup(w, body) {
+		return
+	}
+	f.Name = body.Name
+
+	writeJSON(w, http.StatusOK, mailerlite.RootForm{Data: s.renderForm(f)})
+}
+
+func (s *Server) deleteForm(w http.ResponseWriter, formID string) {
+	for i, f := range s.forms {
+		if f.Id == formID {
+			s.forms = append(s.forms[:i], s.forms[i+1:]...)
+			writeJSON(w, http.StatusNoContent, nil)
+			return
+		}
+	}
+
+	writeNotFound(w)
+}
+
+func (s *Server) formSubscribers(w http.ResponseWriter, r *http.Request, formID string) {
+	f := s.findForm(formID)
+	if f == nil {
+		writeNotFound(w)
+		return
+	}
+
+	members := s.filterSubscribers(r, s.formMembers(f))
+
+	p := s.paginate(r, len(members))
+	root := mailerlite.RootSubscribers{Data: []mailerlite.Subscriber{}, Links: p.links, Meta: p.meta}
+	for _, sub := range members[p.start:p.end] {
+		root.Data = append(root.Data, s.renderSubscriber(sub))
+	}
+
+	writeJSON(w, http.StatusOK, root)
+}
@@ -0,0 +1,300 @@
+package mailerlitetest
+
+import (
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/mailerlite/mailerlite-go"
+)
+
+// Groups returns a snapshot of the groups stored by the server.
+func (s *Server) Groups() []mailerlite.Group {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	groups := make([]mailerlite.Group, len(s.groups))
+	for i, g := range s.groups {
+		groups[i] = s.renderGroup(g)
+	}
+	return groups
+}
+
+func (s *Server) routeGroups(w http.ResponseWriter, r *http.Request, parts []string) {
+	switch {
+	case len(parts) == 0 && r.Method == http.MethodGet:
+		s.listGroups(w, r)
+	case len(parts) == 0 && r.Method == http.MethodPost:
+		s.createGroup(w, r)
+	case len(parts) == 1 && r.Method == http.MethodPut:
+		s.updateGroup(w, r, parts[0])
+	case len(parts) == 1 && r.Method == http.MethodDelete:
+		s.deleteGroup(w, parts[0])
+	case len(parts) == 2 && parts[1] == "subscribers" && r.Method == http.MethodGet:
+		s.groupSubscribers(w, r, parts[0])
+	case len(parts) == 2 && parts[1] == "import-subscribers" && r.Method == http.MethodPost:
+		s.importSubscribers(w, r, parts[0])
+	default:
+		writeNotFound(w)
+	}
+}
+
+func (s *Server) findGroup(groupID string) *mailerlite.Group {
+	for _, g := range s.groups {
+		if g.ID == groupID {
+			return g
+		}
+	}
+	return nil
+}
+
+// members returns the subscribers assigned to the group.
+func (s *Server) members(groupID string) []*mailerlite.Subscriber {
+	var members []*mailerlite.Subscriber
+	for _, sub := range s.subscribers {
+		for _, id := range s.memberships[sub.ID] {
+			if id == groupID {
+				members = append(members, sub)
+				break
+			}
+		}
+	}
+	return members
+}
+
+// renderGroup returns a copy of g with up to date subscriber counts.
+func (s *Server) renderGroup(g *mailerlite.Group) mailerlite.Group {
+	rendered := *g
+	rendered.ActiveCount, rendered.UnsubscribedCount, rendered.UnconfirmedCount = 0, 0, 0
+	rendered.BouncedCount, rendered.JunkCount = 0, 0
+
+	for _, sub := range s.members(g.ID) {
+		switch sub.Status {
+		case "active":
+			rendered.ActiveCount++
+		case "unsubscribed":
+			rendered.UnsubscribedCount++
+		case "unconfirmed":
+			rendered.UnconfirmedCount++
+		case "bounced":
+			rendered.BouncedCount++
+		case "junk":
+			rendered.JunkCount++
+		}
+	}
+
+	return rendered
+}
+
+func (s *Server) listGroups(w http.ResponseWriter, r *http.Request) {
+	groups := make([]mailerlite.Group, 0, len(s.groups))
+	name := strings.ToLower(filter(r, "name"))
+	for _, g := range s.groups {
+		if name == "" || strings.Contains(strings.ToLower(g.Name), name) {
+			groups = append(groups, s.renderGroup(g))
+		}
+	}
+
+	sortGroups(groups, r.URL.Query().Get("sort"))
+
+	p := s.paginate(r, len(groups))
+	writeJSON(w, http.StatusOK, mailerlite.RootGroups{Data: groups[p.start:p.end], Links: p.links, Meta: p.meta})
+}
+
+func sortGroups(groups []mailerlite.Group, by string) {
+	desc := strings.HasPrefix(by, "-")
+	var less func(a, b mailerlite.Group) bool
+
+	switch strings.TrimPrefix(by, "-") {
+	case "name":
+		less = func(a, b mailerlite.Group) bool { return a.Name < b.Name }
+	case "total":
+		less = func(a, b mailerlite.Group) bool { return a.ActiveCount < b.ActiveCount }
+	case "created_at":
+		less = func(a, b mailerlite.Group) bool { return a.CreatedAt.Before(b.CreatedAt.Time) }
+	default:
+		return
+	}
+
+	sort.SliceStable(groups, func(i, j int) bool {
+		if desc {
+			return less(groups[j], groups[i])
+		}
+		return less(groups[i], groups[j])
+	})
+}
+
+type groupBody struct {
+	Name string `json:"name"`
+}
+
+func validateGroup(w http.ResponseWriter, body groupBody) bool {
+	errs := validationErrors{}
+	if body.Name == "" {
+		errs.add("name", "The name field is required.")
+	} else if len(body.Name) > 255 {
+		errs.add("name", "The name may not be greater than 255 characters.")
+	}
+	return errs.write(w)
+}
+
+func (s *Server) createGroup(w http.ResponseWriter, r *http.Request) {
+	var body groupBody
+	if !decodeBody(w, r, &body) || validateGroup(w, body) {
+		return
+	}
+
+	g := &mailerlite.Group{ID: s.newID(), Name: body.Name, CreatedAt: now()}
+	s.groups = append(s.groups, g)
+
+	writeJSON(w, http.StatusCreated, mailerlite.RootGroup{Data: s.renderGroup(g)})
+}
+
+func (s *Server) updateGroup(w http.ResponseWriter, r *http.Request, groupID string) {
+	g := s.findGroup(groupID)
+	if g == nil {
+		writeNotFound(w)
+		return
+	}
+
+	var body groupBody
+	if !decodeBody(w, r, &body) || validateGroup(w, body) {
+		return
+	}
+	g.Name = body.Name
+
+	writeJSON(w, http.StatusOK, mailerlite.RootGroup{Data: s.renderGroup(g)})
+}
+
+func (s *Server) deleteGroup(w http.ResponseWriter, groupID string) {
+	for i, g := range s.groups {
+		if g.ID == groupID {
+			s.groups = append(s.groups[:i], s.groups[i+1:]...)
+			for subscriberID := range s.memberships {
+				s.unassign(subscriberID, groupID)
+			}
+			writeJSON(w, http.StatusNoContent, nil)
+			return
+		}
+	}
+
+	writeNotFound(w)
+}
+
+func (s *Server) groupSubscribers(w http.ResponseWriter, r *http.Request, groupID string) {
+	if s.findGroup(groupID) == nil {
+		writeNotFound(w)
+		return
+	}
+
+	members := s.filterSubscribers(r, s.members(groupID))
+
+	p := s.paginate(r, len(members))
+	root := mailerlite.RootSubscribers{Data: []mailerlite.Subscriber{}, Links: p.links, Meta: p.meta}
+	for _, sub := range members[p.start:p.end] {
+		root.Data = append(root.Data, s.renderSubscriber(sub))
+	}
+
+	writeJSON(w, http.StatusOK, root)
+}
+
+func (s *Server) routeMembership(w http.ResponseWriter, r *http.Request, subscriberID, groupID string) {
+	sub := s.findSubscriber(subscriberID)
+	g := s.findGroup(groupID)
+	if sub == nil || g == nil {
+		writeNotFound(w)
+		return
+	}
+
+	switch r.Method {
+	case http.MethodPost:
+		s.assign(sub.ID, g.ID)
+		writeJSON(w, http.StatusOK, mailerlite.RootGroup{Data: s.renderGroup(g)})
+	case http.MethodDelete:
+		s.unassign(sub.ID, g.ID)
+		writeJSON(w, http.StatusNoContent, nil)
+	default:
+		writeMethodNotAllowed(w)
+	}
+}
+
+func (s *Server) assign(subscriberID, groupID string) {
+	for _, id := range s.memberships[subscriberID] {
+		if id == groupID {
+			return
+		}
+	}
+	s.memberships[subscriberID] = append(s.memberships[subscriberID], groupID)
+}
+
+func (s *Server) unassign(subscriberID, groupID string) {
+	groups := s.memberships[subscriberID]
+	for i, id := range groups {
+		if id == groupID {
+			s.memberships[subscriberID] = append(groups[:i], groups[i+1:]...)
+			return
+		}
+	}
+}
+
+func (s *Server) importSubscribers(w http.ResponseWriter, r *http.Request, groupID string) {
+	if s.findGroup(groupID) == nil {
+		writeNotFound(w)
+		return
+	}
+
+	var body mailerlite.ImportSubscribersOptions
+	if !decodeBody(w, r, &body) {
+		return
+	}
+
+	if len(body.Subscribers) == 0 {
+		errs := validationErrors{}
+		errs.add("subscribers", "The subscribers field is required.")
+		errs.write(w)
+		return
+	}
+
+	imp := &mailerlite.Import{
+		ID:         s.newID(),
+		Total:      len(body.Subscribers),
+		Done:       true,
+		Percent:    100,
+		UpdatedAt:  now(),
+		FinishedAt: now(),
+		Invalid:    []mailerlite.ImportEntry{},
+		Mistyped:   []mailerlite.ImportEntry{},
+		Changed:    []mailerlite.ImportEntry{},
+		Unchanged:  []mailerlite.ImportEntry{},
+		RoleBased:  []mailerlite.ImportEntry{},
+	}
+
+	for _, entry := range body.Subscribers {
+		imp.Processed++
+
+		if !validEmail(entry.Email) {
+			imp.Invalid = append(imp.Invalid, mailerlite.ImportEntry{Email: entry.Email})
+			imp.Errored++
+			continue
+		}
+
+		sub := s.findSubscriber(entry.Email)
+		if sub == nil {
+			sub = s.createSubscriber(entry.Email)
+			imp.Imported++
+		} else {
+			imp.Updated++
+			imp.Changed = append(imp.Changed, mailerlite.ImportEntry{ID: sub.ID, Email: sub.Email})
+		}
+
+		s.applySubscriber(sub, &mailerlite.UpsertSubscriber{Fields: entry.Fields, Groups: []string{groupID}})
+	}
+
+	imp.InvalidCount = len(imp.Invalid)
+	imp.ChangedCount = len(imp.Changed)
+	s.imports[imp.ID] = imp
+
+	writeJSON(w, http.StatusOK, mailerlite.RootImportSubscribers{
+		ImportProgressURL: s.URL + "/subscribers/import/" + imp.ID,
+	})
+}
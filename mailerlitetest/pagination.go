@@ -0,0 +1,166 @@
+package mailerlitetest
+
+import (
+	"encoding/base64"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+
+	"github.com/mailerlite/mailerlite-go"
+)
+
+// page is one page of a list of total items, starting at start and ending
+// before end.
+type page struct {
+	start, end int
+	links      mailerlite.Links
+	meta       mailerlite.Meta
+}
+
+// queryInt returns the integer query parameter key, or def when it is
+// missing or invalid.
+func queryInt(r *http.Request, key string, def int) int {
+	v, err := strconv.Atoi(r.URL.Query().Get(key))
+	if err != nil {
+		return def
+	}
+	return v
+}
+
+// paginate returns the page of a list of total items requested with the
+// "page" and "limit" query parameters.
+func (s *Server) paginate(r *http.Request, total int) page {
+	limit := queryInt(r, "limit", defaultLimit)
+	if limit <= 0 {
+		limit = defaultLimit
+	}
+
+	lastPage := (total + limit - 1) / limit
+	if lastPage == 0 {
+		lastPage = 1
+	}
+
+	current := queryInt(r, "page", 1)
+	if current < 1 {
+		current = 1
+	}
+
+	p := page{start: (current - 1) * limit, end: current * limit}
+	if p.start > total {
+		p.start = total
+	}
+	if p.end > total {
+		p.end = total
+	}
+
+	pageURL := func(n int) string {
+		return s.listURL(r, "page", strconv.Itoa(n))
+	}
+
+	p.links = mailerlite.Links{First: pageURL(1), Last: pageURL(lastPage)}
+	if current > 1 {
+		p.links.Prev = pageURL(current - 1)
+	}
+	if current < lastPage {
+		p.links.Next = pageURL(current + 1)
+	}
+
+	p.meta = mailerlite.Meta{
+		CurrentPage: current,
+		LastPage:    lastPage,
+		Path:        s.server.URL + r.URL.Path,
+		PerPage:     limit,
+		Total:       total,
+	}
+	if p.end > p.start {
+		p.meta.From = p.start + 1
+		p.meta.To = p.end
+	}
+
+	return p
+}
+
+// paginateCursor returns the page of a list of total items requested with
+// the "cursor" and "limit" query parameters.
+func (s *Server) paginateCursor(r *http.Request, total int) (page, bool) {
+	limit := queryInt(r, "limit", defaultLimit)
+	if limit <= 0 {
+		limit = defaultLimit
+	}
+
+	start := 0
+	if cursor := r.URL.Query().Get("cursor"); cursor != "" {
+		offset, ok := decodeCursor(cursor)
+		if !ok {
+			return page{}, false
+		}
+		start = offset
+	}
+	if start > total {
+		start = total
+	}
+
+	p := page{start: start, end: start + limit}
+	if p.end > total {
+		p.end = total
+	}
+
+	p.meta = mailerlite.Meta{
+		Path:    s.server.URL + r.URL.Path,
+		PerPage: limit,
+	}
+	if p.end < total {
+		p.meta.NextCursor = encodeCursor(p.end)
+		p.links.Next = s.listURL(r, "cursor", p.meta.NextCursor)
+	}
+	if start > 0 {
+		prev := start - limit
+		if prev < 0 {
+			prev = 0
+		}
+		p.meta.PrevCursor = encodeCursor(prev)
+		p.links.Prev = s.listURL(r, "cursor", p.meta.PrevCursor)
+	}
+
+	return p, true
+}
+
+// listURL returns the URL of the current request with key set to value.
+func (s *Server) listURL(r *http.Request, key, value string) string {
+	u, _ := url.Parse(s.server.URL + r.URL.RequestURI())
+	q := u.Query()
+	q.Set(key, value)
+	u.RawQuery = q.Encode()
+	return u.String()
+}
+
+func encodeCursor(offset int) string {
+	return base64.RawURLEncoding.EncodeToString([]byte("offset:" + strconv.Itoa(offset)))
+}
+
+func decodeCursor(cursor string) (int, bool) {
+	b, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil || len(b) < len("offset:") || string(b[:len("offset:")]) != "offset:" {
+		return 0, false
+	}
+	offset, err := strconv.Atoi(string(b[len("offset:"):]))
+	if err != nil || offset < 0 {
+		return 0, false
+	}
+	return offset, true
+}
+
+func sortedKeys(m map[string][]string) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// filter returns the "filter[name]" query parameter.
+func filter(r *http.Request, name string) string {
+	return r.URL.Query().Get("filter[" + name + "]")
+}
@@ -0,0 +1,148 @@
+package mailerlitetest
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/mailerlite/mailerlite-go"
+)
+
+// AddSegment stores a segment with the given subscribers, segments can not be
+// created through the API.
+func (s *Server) AddSegment(name string, subscriberIDs ...string) mailerlite.Segment {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	seg := &segment{
+		Segment: mailerlite.Segment{ID: s.newID(), Name: name, CreatedAt: now()},
+		members: subscriberIDs,
+	}
+	s.segments = append(s.segments, seg)
+
+	return s.renderSegment(seg)
+}
+
+func (s *Server) routeSegments(w http.ResponseWriter, r *http.Request, parts []string) {
+	switch {
+	case len(parts) == 0 && r.Method == http.MethodGet:
+		s.listSegments(w, r)
+	case len(parts) == 1 && r.Method == http.MethodPut:
+		s.updateSegment(w, r, parts[0])
+	case len(parts) == 1 && r.Method == http.MethodDelete:
+		s.deleteSegment(w, parts[0])
+	case len(parts) == 2 && parts[1] == "subscribers" && r.Method == http.MethodGet:
+		s.segmentSubscribers(w, r, parts[0])
+	default:
+		writeNotFound(w)
+	}
+}
+
+func (s *Server) findSegment(segmentID string) *segment {
+	for _, seg := range s.segments {
+		if seg.ID == segmentID {
+			return seg
+		}
+	}
+	return nil
+}
+
+// segmentMembers returns the subscribers of seg that still exist.
+func (s *Server) segmentMembers(seg *segment) []*mailerlite.Subscriber {
+	var members []*mailerlite.Subscriber
+	for _, id := range seg.members {
+		if sub := s.findSubscriber(id); sub != nil {
+			members = append(members, sub)
+		}
+	}
+	return members
+}
+
+func (s *Server) renderSegment(seg *segment) mailerlite.Segment {
+	rendered := seg.Segment
+	rendered.Total = len(s.segmentMembers(seg))
+	return rendered
+}
+
+func (s *Server) listSegments(w http.ResponseWriter, r *http.Request) {
+	segments := make([]mailerlite.Segment, len(s.segments))
+	for i, seg := range s.segments {
+		segments[i] = s.renderSegment(seg)
+	}
+
+	p := s.paginate(r, len(segments))
+	writeJSON(w, http.StatusOK, mailerlite.RootSegments{Data: segments[p.start:p.end], Links: p.links, Meta: p.meta})
+}
+
+func (s *Server) updateSegment(w http.ResponseWriter, r *http.Request, segmentID string) {
+	seg := s.findSegment(segmentID)
+	if seg == nil {
+		writeNotFound(w)
+		return
+	}
+
+	var body groupBody
+	if !decodeBody(w, r, &body) || validateGroup(w, body) {
+		return
+	}
+	seg.Name = body.Name
+
+	writeJSON(w, http.StatusOK, mailerlite.RootSegment{Data: s.renderSegment(seg)})
+}
+
+func (s *Server) deleteSegment(w http.ResponseWriter, segmentID string) {
+	for i, seg := range s.segments {
+		if seg.ID == segmentID {
+			s.segments = append(s.segments[:i], s.segments[i+1:]...)
+			writeJSON(w, http.StatusNoContent, nil)
+			return
+		}
+	}
+
+	writeNotFound(w)
+}
+
+// segmentSubscribers lists the subscribers of a segment with the "after"
+// subscriber ID cursor used by the API.
+func (s *Server) segmentSubscribers(w http.ResponseWriter, r *http.Request, segmentID string) {
+	seg := s.findSegment(segmentID)
+	if seg == nil {
+		writeNotFound(w)
+		return
+	}
+
+	members := s.filterSubscribers(r, s.segmentMembers(seg))
+	total := len(members)
+
+	if after := queryInt(r, "after", 0); after > 0 {
+		for i, sub := range members {
+			if id, _ := strconv.Atoi(sub.ID); id > after {
+				members = members[i:]
+				break
+			}
+			if i == len(members)-1 {
+				members = nil
+			}
+		}
+	}
+
+	limit := queryInt(r, "limit", defaultLimit)
+	if limit <= 0 {
+		limit = defaultLimit
+	}
+	if len(members) > limit {
+		members = members[:limit]
+	}
+
+	root := mailerlite.RootSubscribers{
+		Data: []mailerlite.Subscriber{},
+		Meta: mailerlite.Meta{Total: total, Count: len(members)},
+	}
+	for _, sub := range members {
+		root.Data = append(root.Data, s.renderSubscriber(sub))
+	}
+	if len(members) > 0 {
+		root.Meta.Last, _ = strconv.Atoi(members[len(members)-1].ID)
+	}
+
+	writeJSON(w, http.StatusOK, root)
+}
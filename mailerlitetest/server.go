@@ -0,0 +1,358 @@
+// Package mailerlitetest provides an in-memory fake of the MailerLite API for
+// tests.
+//
+// The fake server keeps subscribers, groups, fields, segments, forms,
+// webhooks, campaigns and automations in memory, paginates lists like the
+// API does and returns the same validation errors for the most common
+// mistakes. Rate limiting and failures can be injected to test error handling:
+//
+//	server := mailerlitetest.NewServer()
+//	defer server.Close()
+//
+//	client := server.Client()
+//	client.Subscriber.Upsert(ctx, &mailerlite.UpsertSubscriber{Email: "example@example.com"})
+package mailerlitetest
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/mailerlite/mailerlite-go"
+)
+
+const (
+	// APIKey is the API key accepted by the server.
+	APIKey = "mailerlitetest-api-key"
+
+	apiPath    = "/api"
+	timeLayout = "2006-01-02 15:04:05"
+
+	defaultLimit = 25
+)
+
+// Server is a fake MailerLite API backed by in-memory storage.
+type Server struct {
+	// URL is the base URL of the API, to be passed to mailerlite.WithBaseURL.
+	URL string
+
+	server *httptest.Server
+
+	mu sync.Mutex
+
+	nextID int
+
+	subscribers []*mailerlite.Subscriber
+	memberships map[string][]string // memberships group IDs per subscriber ID
+	groups      []*mailerlite.Group
+	fields      []*mailerlite.Field
+	segments    []*segment
+	forms       []*form
+	webhooks    []*mailerlite.Webhook
+	campaigns   []*campaign
+	automations []*mailerlite.Automation
+	imports     map[string]*mailerlite.Import
+
+	rateLimit   int           // rateLimit requests allowed per rateWindow, zero disables rate limiting.
+	rateWindow  time.Duration // rateWindow the period rateLimit applies to.
+	windowStart time.Time     // windowStart the start of the current rate limit window.
+	windowCount int           // windowCount requests made in the current rate limit window.
+
+	failures []*Failure
+	requests int
+}
+
+type segment struct {
+	mailerlite.Segment
+	members []string
+}
+
+type form struct {
+	mailerlite.Form
+	members []string
+}
+
+type campaign struct {
+	mailerlite.Campaign
+	groups   []string
+	segments []string
+}
+
+// Failure is a failure injected with Server.InjectFailure.
+type Failure struct {
+	Method string // Method to match, any method when empty.
+	Path   string // Path prefix to match relative to the API base, e.g. "/subscribers", any path when empty.
+	Status int    // Status code of the response, zero drops the connection instead.
+	Times  int    // Times the failure is returned, forever when negative and once when zero.
+}
+
+func (f *Failure) matches(r *http.Request, path string) bool {
+	if f.Method != "" && f.Method != r.Method {
+		return false
+	}
+	return strings.HasPrefix(path, f.Path)
+}
+
+// NewServer starts and returns a new Server, seeded with the default
+// subscriber fields of a MailerLite account. The caller should call Close
+// when finished, to shut it down.
+func NewServer() *Server {
+	s := &Server{
+		memberships: make(map[string][]string),
+		imports:     make(map[string]*mailerlite.Import),
+	}
+
+	for _, f := range []struct{ name, key, typ string }{
+		{"Name", "name", "text"},
+		{"Last name", "last_name", "text"},
+		{"Company", "company", "text"},
+		{"Country", "country", "text"},
+		{"City", "city", "text"},
+		{"Phone", "phone", "text"},
+		{"State", "state", "text"},
+		{"Zip", "z_i_p", "text"},
+	} {
+		s.fields = append(s.fields, &mailerlite.Field{Id: s.newID(), Name: f.name, Key: f.key, Type: f.typ})
+	}
+
+	s.server = httptest.NewServer(http.HandlerFunc(s.serveHTTP))
+	s.URL = s.server.URL + apiPath
+
+	return s
+}
+
+// Close shuts down the server.
+func (s *Server) Close() {
+	s.server.Close()
+}
+
+// Client returns a mailerlite.Client talking to the server. The given
+// options are applied after the ones pointing the client to the server.
+func (s *Server) Client(opts ...mailerlite.ClientOption) *mailerlite.Client {
+	opts = append([]mailerlite.ClientOption{
+		mailerlite.WithBaseURL(s.URL),
+		mailerlite.WithHTTPClient(s.server.Client()),
+	}, opts...)
+
+	return mailerlite.NewClient(APIKey, opts...)
+}
+
+// SetRateLimit limits the server to limit requests per window. Requests over
+// the limit get a 429 response with a Retry-After header. A limit of zero
+// disables rate limiting, which is the default.
+func (s *Server) SetRateLimit(limit int, window time.Duration) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.rateLimit = limit
+	s.rateWindow = window
+	s.windowStart = time.Now()
+	s.windowCount = 0
+}
+
+// InjectFailure makes matching requests fail. Failures are matched in the
+// order they were injected.
+func (s *Server) InjectFailure(f Failure) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if f.Times == 0 {
+		f.Times = 1
+	}
+	s.failures = append(s.failures, &f)
+}
+
+// Requests returns the number of requests the server received.
+func (s *Server) Requests() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.requests
+}
+
+func (s *Server) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.requests++
+
+	if !strings.HasPrefix(r.URL.Path, apiPath+"/") {
+		writeError(w, http.StatusNotFound, "Not found.", nil)
+		return
+	}
+	path := strings.TrimPrefix(r.URL.Path, apiPath)
+
+	if r.Header.Get("Authorization") != "Bearer "+APIKey {
+		writeError(w, http.StatusUnauthorized, "Unauthenticated.", nil)
+		return
+	}
+
+	if !s.takeRateLimit(w) {
+		return
+	}
+
+	if s.injectFailure(w, r, path) {
+		return
+	}
+
+	s.route(w, r, strings.Split(strings.Trim(path, "/"), "/"))
+}
+
+// takeRateLimit counts the request against the rate limit, writing a 429
+// response and returning false when the limit is exceeded.
+func (s *Server) takeRateLimit(w http.ResponseWriter) bool {
+	if s.rateLimit == 0 {
+		return true
+	}
+
+	now := time.Now()
+	if now.Sub(s.windowStart) >= s.rateWindow {
+		s.windowStart = now
+		s.windowCount = 0
+	}
+
+	w.Header().Set(mailerlite.HeaderRateLimit, strconv.Itoa(s.rateLimit))
+
+	if s.windowCount >= s.rateLimit {
+		retryAfter := s.windowStart.Add(s.rateWindow).Sub(now)
+		w.Header().Set(mailerlite.HeaderRateRemaining, "0")
+		w.Header().Set(mailerlite.HeaderRateRetryAfter, strconv.Itoa(int(math.Ceil(retryAfter.Seconds()))))
+		writeError(w, http.StatusTooManyRequests, "Too Many Attempts.", nil)
+		return false
+	}
+
+	s.windowCount++
+	w.Header().Set(mailerlite.HeaderRateRemaining, strconv.Itoa(s.rateLimit-s.windowCount))
+
+	return true
+}
+
+// injectFailure writes the first matching injected failure, returning false
+// when there is none.
+func (s *Server) injectFailure(w http.ResponseWriter, r *http.Request, path string) bool {
+	for i, f := range s.failures {
+		if !f.matches(r, path) {
+			continue
+		}
+
+		if f.Times > 0 {
+			f.Times--
+			if f.Times == 0 {
+				s.failures = append(s.failures[:i], s.failures[i+1:]...)
+			}
+		}
+
+		if f.Status == 0 {
+			if hijacker, ok := w.(http.Hijacker); ok {
+				if conn, _, err := hijacker.Hijack(); err == nil {
+					conn.Close()
+					return true
+				}
+			}
+			f.Status = http.StatusBadGateway
+		}
+
+		writeError(w, f.Status, http.StatusText(f.Status), nil)
+		return true
+	}
+
+	return false
+}
+
+func (s *Server) route(w http.ResponseWriter, r *http.Request, parts []string) {
+	switch parts[0] {
+	case "subscribers":
+		s.routeSubscribers(w, r, parts[1:])
+	case "groups":
+		s.routeGroups(w, r, parts[1:])
+	case "fields":
+		s.routeFields(w, r, parts[1:])
+	case "segments":
+		s.routeSegments(w, r, parts[1:])
+	case "forms":
+		s.routeForms(w, r, parts[1:])
+	case "webhooks":
+		s.routeWebhooks(w, r, parts[1:])
+	case "campaigns":
+		s.routeCampaigns(w, r, parts[1:])
+	case "automations":
+		s.routeAutomations(w, r, parts[1:])
+	case "timezones":
+		s.routeTimezones(w, r, parts[1:])
+	case "batch":
+		s.routeBatch(w, r, parts[1:])
+	default:
+		writeNotFound(w)
+	}
+}
+
+func (s *Server) newID() string {
+	s.nextID++
+	return strconv.Itoa(s.nextID)
+}
+
+func now() mailerlite.Timestamp {
+	return mailerlite.NewTimestamp(time.Now().UTC().Truncate(time.Second))
+}
+
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if v != nil {
+		_ = json.NewEncoder(w).Encode(v)
+	}
+}
+
+func writeError(w http.ResponseWriter, status int, message string, errors map[string][]string) {
+	body := map[string]interface{}{"message": message}
+	if errors != nil {
+		body["errors"] = errors
+	}
+	writeJSON(w, status, body)
+}
+
+func writeNotFound(w http.ResponseWriter) {
+	writeError(w, http.StatusNotFound, "Resource not found.", nil)
+}
+
+func writeMethodNotAllowed(w http.ResponseWriter) {
+	writeError(w, http.StatusMethodNotAllowed, "Method not allowed.", nil)
+}
+
+// validationErrors collects validation failures per field.
+type validationErrors map[string][]string
+
+func (v validationErrors) add(field, format string, args ...interface{}) {
+	v[field] = append(v[field], fmt.Sprintf(format, args...))
+}
+
+// write writes a 422 response and returns true if there are failures.
+func (v validationErrors) write(w http.ResponseWriter) bool {
+	if len(v) == 0 {
+		return false
+	}
+
+	// The API uses the first failure as the message.
+	message := "The given data was invalid."
+	for _, field := range sortedKeys(v) {
+		message = v[field][0]
+		break
+	}
+
+	writeError(w, http.StatusUnprocessableEntity, message, v)
+	return true
+}
+
+func decodeBody(w http.ResponseWriter, r *http.Request, v interface{}) bool {
+	if err := json.NewDecoder(r.Body).Decode(v); err != nil {
+		writeError(w, http.StatusBadRequest, "The request body is not valid JSON.", nil)
+		return false
+	}
+	return true
+}
@@ -0,0 +1,255 @@
+package mailerlitetest_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"path"
+	"testing"
+	"time"
+
+	"github.com/mailerlite/mailerlite-go"
+	"github.com/mailerlite/mailerlite-go/mailerlitetest"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUpsertSubscriber(t *testing.T) {
+	server := mailerlitetest.NewServer()
+	defer server.Close()
+
+	client := server.Client()
+	ctx := context.TODO()
+
+	subscriber := &mailerlite.UpsertSubscriber{
+		Email:  "example@example.com",
+		Fields: map[string]interface{}{"name": "Example"},
+	}
+
+	created, res, err := client.Subscriber.Upsert(ctx, subscriber)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusCreated, res.StatusCode)
+	assert.Equal(t, mailerlite.SubscriberStatusActive, created.Data.Status)
+	assert.Equal(t, "Example", created.Data.Fields["name"])
+
+	subscriber.Status = "unsubscribed"
+	updated, res, err := client.Subscriber.Upsert(ctx, subscriber)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, res.StatusCode)
+	assert.Equal(t, created.Data.ID, updated.Data.ID)
+
+	got, _, err := client.Subscriber.Get(ctx, &mailerlite.GetSubscriberOptions{Email: "example@example.com"})
+	assert.NoError(t, err)
+	assert.Equal(t, mailerlite.SubscriberStatusUnsubscribed, got.Data.Status)
+	assert.Len(t, server.Subscribers(), 1)
+}
+
+func TestUpsertSubscriberValidation(t *testing.T) {
+	server := mailerlitetest.NewServer()
+	defer server.Close()
+
+	client := server.Client()
+
+	_, res, err := client.Subscriber.Upsert(context.TODO(), &mailerlite.UpsertSubscriber{Email: "not-an-email"})
+
+	var validationErr *mailerlite.ValidationError
+	assert.True(t, errors.As(err, &validationErr))
+	assert.Equal(t, http.StatusUnprocessableEntity, res.StatusCode)
+	assert.Equal(t, "email", validationErr.FieldErrors()[0].Field)
+}
+
+func TestGetMissingSubscriber(t *testing.T) {
+	server := mailerlitetest.NewServer()
+	defer server.Close()
+
+	client := server.Client()
+
+	_, _, err := client.Subscriber.Get(context.TODO(), &mailerlite.GetSubscriberOptions{SubscriberID: "404"})
+
+	assert.ErrorIs(t, err, mailerlite.ErrNotFound)
+}
+
+func TestRejectsInvalidAPIKey(t *testing.T) {
+	server := mailerlitetest.NewServer()
+	defer server.Close()
+
+	client := server.Client()
+	client.SetAPIKey("invalid-api-key")
+
+	_, _, err := client.Group.List(context.TODO(), &mailerlite.ListGroupOptions{})
+
+	assert.ErrorIs(t, err, mailerlite.ErrUnauthorized)
+}
+
+func TestListSubscribersWithCursor(t *testing.T) {
+	server := mailerlitetest.NewServer()
+	defer server.Close()
+
+	client := server.Client()
+	ctx := context.TODO()
+
+	for _, email := range []string{"a@example.com", "b@example.com", "c@example.com"} {
+		_, _, err := client.Subscriber.Upsert(ctx, &mailerlite.UpsertSubscriber{Email: email})
+		assert.NoError(t, err)
+	}
+
+	options := &mailerlite.ListSubscriberOptions{Limit: 2}
+
+	first, _, err := client.Subscriber.List(ctx, options)
+	assert.NoError(t, err)
+	assert.Len(t, first.Data, 2)
+	assert.NotEmpty(t, first.Meta.NextCursor)
+
+	options.Cursor = first.Meta.NextCursor
+	second, _, err := client.Subscriber.List(ctx, options)
+	assert.NoError(t, err)
+	assert.Len(t, second.Data, 1)
+	assert.Equal(t, "c@example.com", second.Data[0].Email)
+	assert.Empty(t, second.Meta.NextCursor)
+
+	count, _, err := client.Subscriber.Count(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, 3, count.Total)
+}
+
+func TestGroupMembershipAndImport(t *testing.T) {
+	server := mailerlitetest.NewServer()
+	defer server.Close()
+
+	client := server.Client()
+	ctx := context.TODO()
+
+	group, res, err := client.Group.Create(ctx, "Customers")
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusCreated, res.StatusCode)
+
+	subscriber, _, err := client.Subscriber.Upsert(ctx, &mailerlite.UpsertSubscriber{Email: "a@example.com"})
+	assert.NoError(t, err)
+
+	_, _, err = client.Group.Assign(ctx, group.Data.ID, subscriber.Data.ID)
+	assert.NoError(t, err)
+
+	imported, _, err := client.Group.ImportSubscribers(ctx, group.Data.ID, &mailerlite.ImportSubscribersOptions{
+		Subscribers: []mailerlite.ImportSubscriber{{Email: "b@example.com"}, {Email: "invalid"}},
+	})
+	assert.NoError(t, err)
+
+	imp, _, err := client.Subscriber.GetImport(ctx, path.Base(imported.ImportProgressURL))
+	assert.NoError(t, err)
+	assert.True(t, imp.Data.Done)
+	assert.Equal(t, 1, imp.Data.Imported)
+	assert.Equal(t, 1, imp.Data.InvalidCount)
+
+	members, _, err := client.Group.Subscribers(ctx, &mailerlite.ListGroupSubscriberOptions{GroupID: group.Data.ID})
+	assert.NoError(t, err)
+	assert.Equal(t, 2, members.Meta.Total)
+
+	_, err = client.Group.UnAssign(ctx, group.Data.ID, subscriber.Data.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, server.Groups()[0].ActiveCount)
+}
+
+func TestCampaignSubscribers(t *testing.T) {
+	server := mailerlitetest.NewServer()
+	defer server.Close()
+
+	client := server.Client()
+	ctx := context.TODO()
+
+	group, _, err := client.Group.Create(ctx, "Customers")
+	assert.NoError(t, err)
+
+	_, _, err = client.Subscriber.Upsert(ctx, &mailerlite.UpsertSubscriber{Email: "a@example.com", Groups: []string{group.Data.ID}})
+	assert.NoError(t, err)
+
+	campaign, _, err := client.Campaign.Create(ctx, &mailerlite.CreateCampaign{
+		Name:   "Newsletter",
+		Type:   mailerlite.CampaignTypeRegular,
+		Emails: []mailerlite.Emails{{Subject: "Hello", FromName: "Example", From: "from@example.com"}},
+		Groups: []string{group.Data.ID},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "draft", campaign.Data.Status)
+
+	sent, _, err := client.Campaign.Schedule(ctx, campaign.Data.ID, &mailerlite.ScheduleCampaign{Delivery: mailerlite.CampaignScheduleTypeInstant})
+	assert.NoError(t, err)
+	assert.Equal(t, "sent", sent.Data.Status)
+
+	recipients, _, err := client.Campaign.Subscribers(ctx, &mailerlite.ListCampaignSubscriberOptions{CampaignID: campaign.Data.ID})
+	assert.NoError(t, err)
+	assert.Len(t, recipients.Data, 1)
+	assert.Equal(t, "a@example.com", recipients.Data[0].Subscriber.Email)
+}
+
+func TestRateLimit(t *testing.T) {
+	server := mailerlitetest.NewServer()
+	defer server.Close()
+
+	server.SetRateLimit(1, time.Minute)
+	client := server.Client()
+
+	_, res, err := client.Timezone.List(context.TODO())
+	assert.NoError(t, err)
+	assert.Equal(t, 0, res.Rate.Remaining)
+
+	_, _, err = client.Timezone.List(context.TODO())
+
+	var rateLimitErr *mailerlite.RateLimitError
+	assert.True(t, errors.As(err, &rateLimitErr))
+	assert.Equal(t, 2, server.Requests())
+
+	// The client knows when the limit resets and stops calling the server.
+	_, _, err = client.Timezone.List(context.TODO())
+	assert.ErrorIs(t, err, mailerlite.ErrRateLimited)
+	assert.Equal(t, 2, server.Requests())
+}
+
+func TestInjectFailure(t *testing.T) {
+	server := mailerlitetest.NewServer()
+	defer server.Close()
+
+	policy := mailerlite.DefaultRetryPolicy()
+	policy.BaseDelay = time.Millisecond
+	client := server.Client(mailerlite.WithRetryPolicy(policy))
+
+	server.InjectFailure(mailerlitetest.Failure{Path: "/groups", Status: http.StatusServiceUnavailable})
+	server.InjectFailure(mailerlitetest.Failure{Path: "/fields", Times: 2})
+
+	_, res, err := client.Group.List(context.TODO(), &mailerlite.ListGroupOptions{})
+	assert.NoError(t, err)
+	assert.Equal(t, 2, res.Attempts)
+
+	// Dropped connections may also be retried by the transport, so only the
+	// outcome is checked.
+	fields, _, err := client.Field.List(context.TODO(), &mailerlite.ListFieldOptions{})
+	assert.NoError(t, err)
+	assert.NotEmpty(t, fields.Data)
+}
+
+func TestBatch(t *testing.T) {
+	server := mailerlitetest.NewServer()
+	defer server.Close()
+
+	client := server.Client()
+	ctx := context.TODO()
+
+	group, _, err := client.Group.Create(ctx, "Customers")
+	assert.NoError(t, err)
+
+	batch := mailerlite.NewBatch()
+	upsert := batch.SubscriberUpsert(&mailerlite.UpsertSubscriber{Email: "a@example.com", Groups: []string{group.Data.ID}})
+	invalid := batch.SubscriberUpsert(&mailerlite.UpsertSubscriber{Email: "invalid"})
+	field := batch.FieldCreate("Title", "text")
+
+	root, _, err := client.Batch.Send(ctx, batch)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, root.Successful)
+	assert.Equal(t, 1, root.Failed)
+
+	subscriber, err := upsert.Result()
+	assert.NoError(t, err)
+	assert.Equal(t, group.Data.ID, subscriber.Data.Groups[0].ID)
+	assert.ErrorIs(t, invalid.Err(), mailerlite.ErrValidation)
+	created, err := field.Result()
+	assert.NoError(t, err)
+	assert.Equal(t, "title", created.Data.Key)
+}
@@ -0,0 +1,329 @@
+package mailerlitetest
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/mailerlite/mailerlite-go"
+)
+
+// Subscribers returns a snapshot of the subscribers stored by the server.
+func (s *Server) Subscribers() []mailerlite.Subscriber {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	subscribers := make([]mailerlite.Subscriber, len(s.subscribers))
+	for i, sub := range s.subscribers {
+		subscribers[i] = s.renderSubscriber(sub)
+	}
+	return subscribers
+}
+
+func (s *Server) routeSubscribers(w http.ResponseWriter, r *http.Request, parts []string) {
+	switch {
+	case len(parts) == 0 && r.Method == http.MethodGet:
+		s.listSubscribers(w, r)
+	case len(parts) == 0 && r.Method == http.MethodPost:
+		s.upsertSubscriber(w, r)
+	case len(parts) == 2 && parts[0] == "import" && r.Method == http.MethodGet:
+		s.getImport(w, parts[1])
+	case len(parts) == 1:
+		s.routeSubscriber(w, r, parts[0])
+	case len(parts) == 2 && parts[1] == "forget" && r.Method == http.MethodPost:
+		s.forgetSubscriber(w, parts[0])
+	case len(parts) == 2 && parts[1] == "activity-log" && r.Method == http.MethodGet:
+		s.subscriberActivity(w, r, parts[0])
+	case len(parts) == 3 && parts[1] == "groups":
+		s.routeMembership(w, r, parts[0], parts[2])
+	default:
+		writeNotFound(w)
+	}
+}
+
+func (s *Server) routeSubscriber(w http.ResponseWriter, r *http.Request, idOrEmail string) {
+	sub := s.findSubscriber(idOrEmail)
+	if sub == nil {
+		writeNotFound(w)
+		return
+	}
+
+	switch r.Method {
+	case http.MethodGet:
+		writeJSON(w, http.StatusOK, mailerlite.RootSubscriber{Data: s.renderSubscriber(sub)})
+	case http.MethodPut:
+		var body mailerlite.UpdateSubscriber
+		if !decodeBody(w, r, &body) {
+			return
+		}
+		upsert := mailerlite.UpsertSubscriber(body)
+		upsert.Email = ""
+		if s.validateSubscriber(w, &upsert, sub, false) {
+			return
+		}
+		s.applySubscriber(sub, &upsert)
+		writeJSON(w, http.StatusOK, mailerlite.RootSubscriber{Data: s.renderSubscriber(sub)})
+	case http.MethodDelete:
+		s.deleteSubscriber(sub.ID)
+		writeJSON(w, http.StatusNoContent, nil)
+	default:
+		writeMethodNotAllowed(w)
+	}
+}
+
+func (s *Server) findSubscriber(idOrEmail string) *mailerlite.Subscriber {
+	for _, sub := range s.subscribers {
+		if sub.ID == idOrEmail || strings.EqualFold(sub.Email, idOrEmail) {
+			return sub
+		}
+	}
+	return nil
+}
+
+// renderSubscriber returns a copy of sub with its current groups.
+func (s *Server) renderSubscriber(sub *mailerlite.Subscriber) mailerlite.Subscriber {
+	rendered := *sub
+
+	rendered.Fields = make(map[string]interface{}, len(sub.Fields))
+	for k, v := range sub.Fields {
+		rendered.Fields[k] = v
+	}
+
+	rendered.Groups = []mailerlite.Group{}
+	for _, groupID := range s.memberships[sub.ID] {
+		if g := s.findGroup(groupID); g != nil {
+			rendered.Groups = append(rendered.Groups, s.renderGroup(g))
+		}
+	}
+
+	return rendered
+}
+
+func (s *Server) listSubscribers(w http.ResponseWriter, r *http.Request) {
+	subscribers := s.filterSubscribers(r, s.subscribers)
+
+	// A limit of zero is how SubscriberService.Count asks for the total only.
+	if r.URL.Query().Get("limit") == "0" {
+		writeJSON(w, http.StatusOK, map[string]interface{}{"total": len(subscribers)})
+		return
+	}
+
+	p, ok := s.paginateCursor(r, len(subscribers))
+	if !ok {
+		writeError(w, http.StatusUnprocessableEntity, "The cursor is invalid.", map[string][]string{"cursor": {"The cursor is invalid."}})
+		return
+	}
+
+	root := mailerlite.RootSubscribers{Data: []mailerlite.Subscriber{}, Links: p.links, Meta: p.meta}
+	for _, sub := range subscribers[p.start:p.end] {
+		root.Data = append(root.Data, s.renderSubscriber(sub))
+	}
+
+	writeJSON(w, http.StatusOK, root)
+}
+
+// filterSubscribers applies the "filter[status]" query parameter.
+func (s *Server) filterSubscribers(r *http.Request, subscribers []*mailerlite.Subscriber) []*mailerlite.Subscriber {
+	status := filter(r, "status")
+	if status == "" {
+		return subscribers
+	}
+
+	filtered := make([]*mailerlite.Subscriber, 0, len(subscribers))
+	for _, sub := range subscribers {
+		if string(sub.Status) == status {
+			filtered = append(filtered, sub)
+		}
+	}
+	return filtered
+}
+
+func (s *Server) upsertSubscriber(w http.ResponseWriter, r *http.Request) {
+	var body mailerlite.UpsertSubscriber
+	if !decodeBody(w, r, &body) {
+		return
+	}
+
+	sub := s.findSubscriber(body.Email)
+	if s.validateSubscriber(w, &body, sub, true) {
+		return
+	}
+
+	status := http.StatusOK
+	if sub == nil {
+		sub = s.createSubscriber(body.Email)
+		status = http.StatusCreated
+	}
+
+	s.applySubscriber(sub, &body)
+
+	writeJSON(w, status, mailerlite.RootSubscriber{Data: s.renderSubscriber(sub)})
+}
+
+func (s *Server) createSubscriber(email string) *mailerlite.Subscriber {
+	sub := &mailerlite.Subscriber{
+		ID:           s.newID(),
+		Email:        email,
+		Status:       "active",
+		Source:       "api",
+		SubscribedAt: now(),
+		CreatedAt:    now(),
+		UpdatedAt:    now(),
+		Fields:       make(map[string]interface{}),
+	}
+	for _, f := range s.fields {
+		sub.Fields[f.Key] = nil
+	}
+
+	s.subscribers = append(s.subscribers, sub)
+
+	return sub
+}
+
+// validateSubscriber writes a 422 response and returns true if body is invalid
+// for the current subscriber, nil when it is created.
+func (s *Server) validateSubscriber(w http.ResponseWriter, body *mailerlite.UpsertSubscriber, current *mailerlite.Subscriber, requireEmail bool) bool {
+	errs := validationErrors{}
+
+	if requireEmail && body.Email == "" {
+		errs.add("email", "The email field is required.")
+	} else if requireEmail && !validEmail(body.Email) {
+		errs.add("email", "The email must be a valid email address.")
+	}
+
+	if body.Status != "" && !body.Status.Valid() {
+		errs.add("status", "The selected status is invalid.")
+	} else if current != nil && body.Status != "" && !current.Status.CanTransitionTo(body.Status) {
+		errs.add("status", "The status of a %s subscriber can not be changed to %s.", current.Status, body.Status)
+	}
+
+	for i, groupID := range body.Groups {
+		if s.findGroup(groupID) == nil {
+			errs.add(fmt.Sprintf("groups.%d", i), "The selected groups.%d is invalid.", i)
+		}
+	}
+
+	for key, value := range body.Fields {
+		field := s.findFieldByKey(key)
+		if field == nil || value == nil {
+			// The API ignores unknown fields.
+			continue
+		}
+		if !validFieldValue(field.Type, value) {
+			errs.add("fields."+key, "The fields.%s must be a %s.", key, field.Type)
+		}
+	}
+
+	return errs.write(w)
+}
+
+func validEmail(email string) bool {
+	at := strings.Index(email, "@")
+	return at > 0 && at == strings.LastIndex(email, "@") && strings.Contains(email[at:], ".")
+}
+
+func validFieldValue(fieldType string, value interface{}) bool {
+	switch fieldType {
+	case "number":
+		switch v := value.(type) {
+		case float64:
+			return true
+		case string:
+			_, err := strconv.ParseFloat(v, 64)
+			return err == nil
+		}
+		return false
+	case "date":
+		v, ok := value.(string)
+		if !ok {
+			return false
+		}
+		if _, err := time.Parse("2006-01-02", v); err == nil {
+			return true
+		}
+		_, err := time.Parse(timeLayout, v)
+		return err == nil
+	default:
+		switch value.(type) {
+		case string, float64, bool:
+			return true
+		}
+		return false
+	}
+}
+
+func (s *Server) applySubscriber(sub *mailerlite.Subscriber, body *mailerlite.UpsertSubscriber) {
+	if body.Status != "" && body.Status != sub.Status {
+		sub.Status = body.Status
+		if body.Status == "unsubscribed" {
+			sub.UnsubscribedAt = now()
+		}
+	}
+	if body.IPAddress != nil {
+		sub.IPAddress = body.IPAddress
+	}
+	if body.SubscribedAt != "" {
+		sub.SubscribedAt, _ = mailerlite.ParseTimestamp(body.SubscribedAt)
+	}
+	if body.OptedInAt != "" {
+		sub.OptedInAt, _ = mailerlite.ParseTimestamp(body.OptedInAt)
+	}
+	if body.OptinIP != "" {
+		sub.OptinIP = body.OptinIP
+	}
+	for key, value := range body.Fields {
+		if s.findFieldByKey(key) != nil {
+			sub.Fields[key] = value
+		}
+	}
+	for _, groupID := range body.Groups {
+		s.assign(sub.ID, groupID)
+	}
+
+	sub.UpdatedAt = now()
+}
+
+func (s *Server) deleteSubscriber(subscriberID string) {
+	for i, sub := range s.subscribers {
+		if sub.ID == subscriberID {
+			s.subscribers = append(s.subscribers[:i], s.subscribers[i+1:]...)
+			break
+		}
+	}
+	delete(s.memberships, subscriberID)
+}
+
+func (s *Server) forgetSubscriber(w http.ResponseWriter, subscriberID string) {
+	sub := s.findSubscriber(subscriberID)
+	if sub == nil {
+		writeNotFound(w)
+		return
+	}
+
+	rendered := s.renderSubscriber(sub)
+	s.deleteSubscriber(sub.ID)
+
+	writeJSON(w, http.StatusOK, mailerlite.RootSubscriber{Data: rendered})
+}
+
+func (s *Server) subscriberActivity(w http.ResponseWriter, r *http.Request, subscriberID string) {
+	if s.findSubscriber(subscriberID) == nil {
+		writeNotFound(w)
+		return
+	}
+
+	p := s.paginate(r, 0)
+	writeJSON(w, http.StatusOK, mailerlite.RootActivityLog{Data: []mailerlite.ActivityEntry{}, Links: p.links, Meta: p.meta})
+}
+
+func (s *Server) getImport(w http.ResponseWriter, importID string) {
+	imp, ok := s.imports[importID]
+	if !ok {
+		writeNotFound(w)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, mailerlite.RootImport{Data: *imp})
+}
@@ -0,0 +1,24 @@
+package mailerlitetest
+
+import (
+	"net/http"
+
+	"github.com/mailerlite/mailerlite-go"
+)
+
+var timezones = []mailerlite.Timezone{
+	{Id: "1", Name: "Pacific/Midway", NameForHumans: "Midway Island, Samoa", OffsetName: "-11:00", Offset: -39600},
+	{Id: "6", Name: "America/New_York", NameForHumans: "Eastern Time (US & Canada)", OffsetName: "-05:00", Offset: -18000},
+	{Id: "17", Name: "Europe/London", NameForHumans: "London", OffsetName: "+00:00", Offset: 0},
+	{Id: "28", Name: "Europe/Vilnius", NameForHumans: "Vilnius", OffsetName: "+02:00", Offset: 7200},
+	{Id: "64", Name: "Asia/Tokyo", NameForHumans: "Tokyo", OffsetName: "+09:00", Offset: 32400},
+}
+
+func (s *Server) routeTimezones(w http.ResponseWriter, r *http.Request, parts []string) {
+	if len(parts) != 0 || r.Method != http.MethodGet {
+		writeNotFound(w)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, mailerlite.RootTimezones{Data: timezones})
+}
@@ -0,0 +1,160 @@
+package mailerlitetest
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/url"
+
+	"github.com/mailerlite/mailerlite-go"
+)
+
+func (s *Server) routeWebhooks(w http.ResponseWriter, r *http.Request, parts []string) {
+	switch {
+	case len(parts) == 0 && r.Method == http.MethodGet:
+		s.listWebhooks(w, r)
+	case len(parts) == 0 && r.Method == http.MethodPost:
+		s.createWebhook(w, r)
+	case len(parts) == 1 && r.Method == http.MethodGet:
+		s.getWebhook(w, parts[0])
+	case len(parts) == 1 && r.Method == http.MethodPut:
+		s.updateWebhook(w, r, parts[0])
+	case len(parts) == 1 && r.Method == http.MethodDelete:
+		s.deleteWebhook(w, parts[0])
+	default:
+		writeNotFound(w)
+	}
+}
+
+func (s *Server) findWebhook(webhookID string) *mailerlite.Webhook {
+	for _, wh := range s.webhooks {
+		if wh.Id == webhookID {
+			return wh
+		}
+	}
+	return nil
+}
+
+func validWebhookURL(rawURL string) bool {
+	u, err := url.ParseRequestURI(rawURL)
+	return err == nil && (u.Scheme == "http" || u.Scheme == "https") && u.Host != ""
+}
+
+func (s *Server) listWebhooks(w http.ResponseWriter, r *http.Request) {
+	webhooks := make([]mailerlite.Webhook, len(s.webhooks))
+	for i, wh := range s.webhooks {
+		webhooks[i] = *wh
+	}
+
+	p := s.paginate(r, len(webhooks))
+	writeJSON(w, http.StatusOK, mailerlite.RootWebhooks{Data: webhooks[p.start:p.end], Links: p.links, Meta: p.meta})
+}
+
+func (s *Server) createWebhook(w http.ResponseWriter, r *http.Request) {
+	var body mailerlite.CreateWebhookOptions
+	if !decodeBody(w, r, &body) {
+		return
+	}
+
+	errs := validationErrors{}
+	if len(body.Events) == 0 {
+		errs.add("events", "The events field is required.")
+	}
+	if body.Url == "" {
+		errs.add("url", "The url field is required.")
+	} else if !validWebhookURL(body.Url) {
+		errs.add("url", "The url format is invalid.")
+	}
+	if errs.write(w) {
+		return
+	}
+
+	wh := &mailerlite.Webhook{
+		Id:        s.newID(),
+		Name:      body.Name,
+		Url:       body.Url,
+		Events:    body.Events,
+		Enabled:   true,
+		Secret:    "secret-" + s.newID(),
+		CreatedAt: now(),
+		UpdatedAt: now(),
+	}
+	s.webhooks = append(s.webhooks, wh)
+
+	writeJSON(w, http.StatusCreated, mailerlite.RootWebhook{Data: *wh})
+}
+
+func (s *Server) getWebhook(w http.ResponseWriter, webhookID string) {
+	wh := s.findWebhook(webhookID)
+	if wh == nil {
+		writeNotFound(w)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, mailerlite.RootWebhook{Data: *wh})
+}
+
+func (s *Server) updateWebhook(w http.ResponseWriter, r *http.Request, webhookID string) {
+	wh := s.findWebhook(webhookID)
+	if wh == nil {
+		writeNotFound(w)
+		return
+	}
+
+	var body struct {
+		Name    string          `json:"name"`
+		Events  []string        `json:"events"`
+		Url     string          `json:"url"`
+		Enabled json.RawMessage `json:"enabled"`
+	}
+	if !decodeBody(w, r, &body) {
+		return
+	}
+
+	errs := validationErrors{}
+	if body.Url != "" && !validWebhookURL(body.Url) {
+		errs.add("url", "The url format is invalid.")
+	}
+
+	// UpdateWebhookOptions sends enabled as a string, the API accepts both.
+	var enabled *bool
+	switch string(body.Enabled) {
+	case "", "null", `""`:
+	case "true", `"true"`, `"1"`:
+		enabled = mailerlite.Bool(true)
+	case "false", `"false"`, `"0"`:
+		enabled = mailerlite.Bool(false)
+	default:
+		errs.add("enabled", "The enabled field must be true or false.")
+	}
+	if errs.write(w) {
+		return
+	}
+
+	if body.Name != "" {
+		wh.Name = body.Name
+	}
+	if len(body.Events) > 0 {
+		wh.Events = body.Events
+	}
+	if body.Url != "" {
+		wh.Url = body.Url
+	}
+	if enabled != nil {
+		wh.Enabled = *enabled
+	}
+	wh.UpdatedAt = now()
+
+	writeJSON(w, http.StatusOK, mailerlite.RootWebhook{Data: *wh})
+}
+
+func (s *Server) deleteWebhook(w http.ResponseWriter, webhookID string) {
+	for i, wh := range s.webhooks {
+		if wh.Id == webhookID {
+			s.webhooks = append(s.webhooks[:i], s.webhooks[i+1:]...)
+			writeJSON(w, http.StatusNoContent, nil)
+			return
+		}
+	}
+
+	writeNotFound(w)
+}
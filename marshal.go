@@ -0,0 +1,316 @@
+package mailerlite
+
+import (
+	"encoding"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Keys of the "mailerlite" struct tag mapped to subscriber attributes rather
+// than to custom fields.
+const (
+	tagEmail  = "email"
+	tagStatus = "status"
+	tagGroups = "groups"
+)
+
+var (
+	timeType            = reflect.TypeOf(time.Time{})
+	textMarshalerType   = reflect.TypeOf((*encoding.TextMarshaler)(nil)).Elem()
+	textUnmarshalerType = reflect.TypeOf((*encoding.TextUnmarshaler)(nil)).Elem()
+)
+
+// structField is a struct field tagged with "mailerlite".
+type structField struct {
+	index     []int
+	key       string
+	omitEmpty bool
+}
+
+var structFieldsCache sync.Map // map[reflect.Type][]structField
+
+// structFields returns the tagged fields of t, including the ones of
+// embedded structs.
+func structFields(t reflect.Type) []structField {
+	if fields, ok := structFieldsCache.Load(t); ok {
+		return fields.([]structField)
+	}
+
+	var fields []structField
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+
+		tag, tagged := f.Tag.Lookup("mailerlite")
+		if f.Anonymous && !tagged && f.Type.Kind() == reflect.Struct {
+			for _, embedded := range structFields(f.Type) {
+				embedded.index = append([]int{i}, embedded.index...)
+				fields = append(fields, embedded)
+			}
+			continue
+		}
+		if !tagged || tag == "-" || !f.IsExported() {
+			continue
+		}
+
+		name, opts, _ := strings.Cut(tag, ",")
+		if name == "" {
+			continue
+		}
+		fields = append(fields, structField{index: f.Index, key: name, omitEmpty: opts == "omitempty"})
+	}
+
+	structFieldsCache.Store(t, fields)
+	return fields
+}
+
+// structValue returns the struct v points to.
+func structValue(v interface{}, name string) (reflect.Value, error) {
+	rv := reflect.ValueOf(v)
+	for rv.Kind() == reflect.Pointer {
+		if rv.IsNil() {
+			return reflect.Value{}, fmt.Errorf("mailerlite: %s of nil %T", name, v)
+		}
+		rv = rv.Elem()
+	}
+	if rv.Kind() != reflect.Struct {
+		return reflect.Value{}, fmt.Errorf("mailerlite: %s of non struct %T", name, v)
+	}
+	return rv, nil
+}
+
+// MarshalSubscriber returns the subscriber described by the struct v, whose
+// fields are mapped with "mailerlite" struct tags:
+//
+//	type Customer struct {
+//		Email    string    `mailerlite:"email"`
+//		Status   string    `mailerlite:"status,omitempty"` // Or a SubscriberStatus.
+//		GroupIDs []string  `mailerlite:"groups,omitempty"`
+//		Name     string    `mailerlite:"name"`
+//		Orders   int       `mailerlite:"orders,omitempty"`
+//		Birthday time.Time `mailerlite:"birthday,omitempty"`
+//		Internal string    // Not tagged, ignored.
+//	}
+//
+// The "email", "status" and "groups" keys set the matching subscriber
+// attributes, any other key sets the custom field of that key. Numbers are
+// sent as numbers, time.Time as a date and encoding.TextMarshaler
+// implementations as text. With "omitempty", zero values are left out,
+// otherwise they are sent, nil pointers and zero times clearing the field.
+func MarshalSubscriber(v interface{}) (*UpsertSubscriber, error) {
+	rv, err := structValue(v, "MarshalSubscriber")
+	if err != nil {
+		return nil, err
+	}
+
+	subscriber := &UpsertSubscriber{}
+	for _, f := range structFields(rv.Type()) {
+		fv := rv.FieldByIndex(f.index)
+		if f.omitEmpty && fv.IsZero() {
+			continue
+		}
+
+		switch f.key {
+		case tagEmail, tagStatus:
+			if fv.Kind() != reflect.String {
+				return nil, fmt.Errorf("mailerlite: %s must be a string, not %s", f.key, fv.Type())
+			}
+			if f.key == tagEmail {
+				subscriber.Email = fv.String()
+			} else {
+				subscriber.Status = SubscriberStatus(fv.String())
+			}
+		case tagGroups:
+			groups, ok := fv.Interface().([]string)
+			if !ok {
+				return nil, fmt.Errorf("mailerlite: groups must be a []string, not %s", fv.Type())
+			}
+			subscriber.Groups = groups
+		default:
+			value, err := marshalFieldValue(fv)
+			if err != nil {
+				return nil, fmt.Errorf("mailerlite: field %s: %w", f.key, err)
+			}
+			if subscriber.Fields == nil {
+				subscriber.Fields = make(map[string]interface{})
+			}
+			subscriber.Fields[f.key] = value
+		}
+	}
+	return subscriber, nil
+}
+
+func marshalFieldValue(v reflect.Value) (interface{}, error) {
+	if v.Kind() == reflect.Pointer {
+		if v.IsNil() {
+			return nil, nil
+		}
+		v = v.Elem()
+	}
+
+	if v.Type() == timeType {
+		t := v.Interface().(time.Time)
+		if t.IsZero() {
+			return nil, nil
+		}
+		return t.Format(FieldDateLayout), nil
+	}
+	if v.Type().Implements(textMarshalerType) {
+		text, err := v.Interface().(encoding.TextMarshaler).MarshalText()
+		return string(text), err
+	}
+
+	switch v.Kind() {
+	case reflect.String:
+		return v.String(), nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return v.Int(), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return v.Uint(), nil
+	case reflect.Float32, reflect.Float64:
+		return v.Float(), nil
+	}
+	return nil, fmt.Errorf("unsupported type %s", v.Type())
+}
+
+// UnmarshalSubscriber sets the fields of the struct v points to from the
+// subscriber, following the "mailerlite" struct tags described in
+// MarshalSubscriber. The "groups" key gets the IDs of the groups of the
+// subscriber. Fields which are not set on the subscriber get their zero
+// value.
+func UnmarshalSubscriber(subscriber *Subscriber, v interface{}) error {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Pointer || rv.IsNil() {
+		return fmt.Errorf("mailerlite: UnmarshalSubscriber of non pointer %T", v)
+	}
+	rv, err := structValue(v, "UnmarshalSubscriber")
+	if err != nil {
+		return err
+	}
+
+	for _, f := range structFields(rv.Type()) {
+		fv := rv.FieldByIndex(f.index)
+
+		switch f.key {
+		case tagEmail, tagStatus:
+			if fv.Kind() != reflect.String {
+				return fmt.Errorf("mailerlite: %s must be a string, not %s", f.key, fv.Type())
+			}
+			if f.key == tagEmail {
+				fv.SetString(subscriber.Email)
+			} else {
+				fv.SetString(string(subscriber.Status))
+			}
+		case tagGroups:
+			if fv.Type() != reflect.TypeOf([]string(nil)) {
+				return fmt.Errorf("mailerlite: groups must be a []string, not %s", fv.Type())
+			}
+			ids := make([]string, len(subscriber.Groups))
+			for i, group := range subscriber.Groups {
+				ids[i] = group.ID
+			}
+			fv.Set(reflect.ValueOf(ids))
+		default:
+			if err := unmarshalFieldValue(subscriber.Fields[f.key], fv); err != nil {
+				return fmt.Errorf("mailerlite: field %s: %w", f.key, err)
+			}
+		}
+	}
+	return nil
+}
+
+var errUnmarshalType = errors.New("can not unmarshal")
+
+func unmarshalFieldValue(value interface{}, v reflect.Value) error {
+	if value == nil {
+		v.Set(reflect.Zero(v.Type()))
+		return nil
+	}
+	if v.Kind() == reflect.Pointer {
+		v.Set(reflect.New(v.Type().Elem()))
+		v = v.Elem()
+	}
+
+	text, isText := value.(string)
+	if n, ok := value.(json.Number); ok {
+		text, isText = n.String(), true
+	}
+	if f, ok := value.(float64); ok {
+		text, isText = strconv.FormatFloat(f, 'f', -1, 64), true
+	}
+	if !isText {
+		return fmt.Errorf("%w %T into %s", errUnmarshalType, value, v.Type())
+	}
+
+	if v.Type() == timeType {
+		if strings.TrimSpace(text) == "" {
+			v.Set(reflect.Zero(v.Type()))
+			return nil
+		}
+		date, err := convertDate(text)
+		if err != nil {
+			return err
+		}
+		t, err := time.Parse(FieldDateLayout, date.(string))
+		if err != nil {
+			return err
+		}
+		v.Set(reflect.ValueOf(t))
+		return nil
+	}
+	if reflect.PointerTo(v.Type()).Implements(textUnmarshalerType) {
+		return v.Addr().Interface().(encoding.TextUnmarshaler).UnmarshalText([]byte(text))
+	}
+
+	switch v.Kind() {
+	case reflect.String:
+		v.SetString(text)
+		return nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		f, err := parseFieldNumber(text)
+		if err != nil {
+			return err
+		}
+		if v.OverflowInt(int64(f)) || f != float64(int64(f)) {
+			return fmt.Errorf("%w %s into %s", errUnmarshalType, text, v.Type())
+		}
+		v.SetInt(int64(f))
+		return nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		f, err := parseFieldNumber(text)
+		if err != nil {
+			return err
+		}
+		if f < 0 || v.OverflowUint(uint64(f)) || f != float64(uint64(f)) {
+			return fmt.Errorf("%w %s into %s", errUnmarshalType, text, v.Type())
+		}
+		v.SetUint(uint64(f))
+		return nil
+	case reflect.Float32, reflect.Float64:
+		f, err := parseFieldNumber(text)
+		if err != nil {
+			return err
+		}
+		v.SetFloat(f)
+		return nil
+	}
+	return fmt.Errorf("%w %T into %s", errUnmarshalType, value, v.Type())
+}
+
+// parseFieldNumber parses the value of a number field, empty being zero.
+func parseFieldNumber(text string) (float64, error) {
+	text = strings.TrimSpace(text)
+	if text == "" {
+		return 0, nil
+	}
+	f, err := strconv.ParseFloat(text, 64)
+	if err != nil {
+		return 0, fmt.Errorf("%w: %q is not a number", ErrInvalidFieldValue, text)
+	}
+	return f, nil
+}
@@ -0,0 +1,144 @@
+package mailerlite_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/mailerlite/mailerlite-go"
+	"github.com/mailerlite/mailerlite-go/mailerlitetest"
+	"github.com/stretchr/testify/assert"
+)
+
+type Address struct {
+	City string `mailerlite:"city,omitempty"`
+}
+
+type Customer struct {
+	Address
+	Email    string     `mailerlite:"email"`
+	Status   string     `mailerlite:"status,omitempty"`
+	GroupIDs []string   `mailerlite:"groups,omitempty"`
+	Name     string     `mailerlite:"name"`
+	Company  *string    `mailerlite:"company"`
+	Orders   int        `mailerlite:"orders,omitempty"`
+	Balance  float64    `mailerlite:"balance"`
+	Birthday time.Time  `mailerlite:"birthday,omitempty"`
+	LastSeen *time.Time `mailerlite:"last_seen,omitempty"`
+	Notes    string     `mailerlite:"-"`
+	Internal string
+}
+
+func TestMarshalSubscriber(t *testing.T) {
+	customer := Customer{
+		Address:  Address{City: "Vilnius"},
+		Email:    "example@example.com",
+		GroupIDs: []string{"1"},
+		Name:     "Example",
+		Orders:   3,
+		Balance:  12.5,
+		Birthday: time.Date(1990, 1, 31, 0, 0, 0, 0, time.UTC),
+		Notes:    "ignored",
+		Internal: "ignored",
+	}
+
+	subscriber, err := mailerlite.MarshalSubscriber(&customer)
+	assert.NoError(t, err)
+	assert.Equal(t, &mailerlite.UpsertSubscriber{
+		Email:  "example@example.com",
+		Groups: []string{"1"},
+		Fields: map[string]interface{}{
+			"city":     "Vilnius",
+			"name":     "Example",
+			"company":  nil,
+			"orders":   int64(3),
+			"balance":  12.5,
+			"birthday": "1990-01-31",
+		},
+	}, subscriber)
+
+	_, err = mailerlite.MarshalSubscriber(struct {
+		Email []string `mailerlite:"email"`
+	}{})
+	assert.Error(t, err)
+
+	_, err = mailerlite.MarshalSubscriber("example@example.com")
+	assert.Error(t, err)
+}
+
+func TestUnmarshalSubscriber(t *testing.T) {
+	subscriber := &mailerlite.Subscriber{
+		Email:  "example@example.com",
+		Status: "active",
+		Groups: []mailerlite.Group{{ID: "1", Name: "Customers"}},
+		Fields: map[string]interface{}{
+			"city":      "Vilnius",
+			"name":      "Example",
+			"company":   "MailerLite",
+			"orders":    float64(3),
+			"balance":   "12.5",
+			"birthday":  "1990-01-31",
+			"last_seen": "2024-05-01 10:00:00",
+		},
+	}
+
+	customer := Customer{Notes: "kept"}
+	assert.NoError(t, mailerlite.UnmarshalSubscriber(subscriber, &customer))
+
+	assert.Equal(t, "Vilnius", customer.City)
+	assert.Equal(t, "example@example.com", customer.Email)
+	assert.Equal(t, "active", customer.Status)
+	assert.Equal(t, []string{"1"}, customer.GroupIDs)
+	assert.Equal(t, "MailerLite", *customer.Company)
+	assert.Equal(t, 3, customer.Orders)
+	assert.Equal(t, 12.5, customer.Balance)
+	assert.Equal(t, time.Date(1990, 1, 31, 0, 0, 0, 0, time.UTC), customer.Birthday)
+	assert.Equal(t, time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC), *customer.LastSeen)
+	assert.Equal(t, "kept", customer.Notes)
+
+	subscriber.Fields["orders"] = "many"
+	err := mailerlite.UnmarshalSubscriber(subscriber, &customer)
+	assert.ErrorIs(t, err, mailerlite.ErrInvalidFieldValue)
+
+	subscriber.Fields["orders"] = 1.5
+	assert.Error(t, mailerlite.UnmarshalSubscriber(subscriber, &customer))
+
+	assert.Error(t, mailerlite.UnmarshalSubscriber(subscriber, customer))
+}
+
+func TestMarshalSubscriberRoundTrip(t *testing.T) {
+	server := mailerlitetest.NewServer()
+	defer server.Close()
+
+	client := server.Client()
+	for _, field := range []struct{ name, typ string }{{"Orders", "number"}, {"Balance", "number"}, {"Birthday", "date"}, {"Last seen", "date"}} {
+		_, _, err := client.Field.Create(context.TODO(), field.name, field.typ)
+		assert.NoError(t, err)
+	}
+
+	company := "MailerLite"
+	customer := Customer{
+		Email:    "example@example.com",
+		Name:     "Example",
+		Company:  &company,
+		Orders:   3,
+		Balance:  12.5,
+		Birthday: time.Date(1990, 1, 31, 0, 0, 0, 0, time.UTC),
+	}
+
+	upsert, err := mailerlite.MarshalSubscriber(customer)
+	assert.NoError(t, err)
+
+	root, _, err := client.Subscriber.Upsert(context.TODO(), upsert)
+	assert.NoError(t, err)
+
+	var got Customer
+	assert.NoError(t, mailerlite.UnmarshalSubscriber(&root.Data, &got))
+	assert.Equal(t, customer.Email, got.Email)
+	assert.Equal(t, customer.Name, got.Name)
+	assert.Equal(t, company, *got.Company)
+	assert.Equal(t, customer.Orders, got.Orders)
+	assert.Equal(t, customer.Balance, got.Balance)
+	assert.Equal(t, customer.Birthday, got.Birthday)
+	assert.Nil(t, got.LastSeen)
+}
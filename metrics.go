@@ -0,0 +1,87 @@
+package mailerlite
+
+import (
+	"errors"
+	"net/http"
+	"time"
+)
+
+// RequestMetrics describes a single API call.
+type RequestMetrics struct {
+	Operation string        // Operation the service operation, e.g. "Subscriber.Upsert".
+	Method    string        // Method the HTTP method of the request.
+	Status    int           // Status the HTTP status code, zero when no response was received.
+	Duration  time.Duration // Duration of the call, including retries and rate limit waits.
+	Attempts  int           // Attempts the number of times the request was sent.
+	Rate      Rate          // Rate the rate limit returned by the API.
+	Err       error         // Err the error returned by the call, nil on success.
+}
+
+// Metrics receives measurements of every API call made by the client.
+// Implementations must be safe for concurrent use.
+type Metrics interface {
+	ObserveRequest(m RequestMetrics)
+}
+
+// WithMetrics reports every API call made by the client to metrics.
+func WithMetrics(metrics Metrics) ClientOption {
+	return WithMiddleware(MetricsMiddleware(metrics))
+}
+
+// MetricsMiddleware returns a Middleware reporting every API call to metrics.
+func MetricsMiddleware(metrics Metrics) Middleware {
+	return func(next Doer) Doer {
+		return DoerFunc(func(req *http.Request) (*Response, error) {
+			start := time.Now()
+			res, err := next.Do(req)
+
+			m := RequestMetrics{
+				Operation: Operation(req.Context()),
+				Method:    req.Method,
+				Duration:  time.Since(start),
+				Err:       err,
+			}
+			if res != nil && res.Response != nil {
+				m.Status = res.StatusCode
+				m.Attempts = res.Attempts
+				m.Rate = res.Rate
+			}
+
+			metrics.ObserveRequest(m)
+
+			return res, err
+		})
+	}
+}
+
+// ErrorClass returns the class of an error returned by the client, suitable
+// as a metric label: "AuthError", "NotFoundError", "ValidationError",
+// "RateLimitError", "ServerError", "ErrorResponse" or "Other". It returns an
+// empty string for a nil error.
+func ErrorClass(err error) string {
+	switch err.(type) {
+	case nil:
+		return ""
+	case *AuthError:
+		return "AuthError"
+	case *RateLimitError:
+		return "RateLimitError"
+	case *ErrorResponse:
+		// NotFoundError, ValidationError and ServerError are views of the
+		// ErrorResponse, by status code.
+		var notFound *NotFoundError
+		var validation *ValidationError
+		var server *ServerError
+		switch {
+		case errors.As(err, &notFound):
+			return "NotFoundError"
+		case errors.As(err, &validation):
+			return "ValidationError"
+		case errors.As(err, &server):
+			return "ServerError"
+		}
+		return "ErrorResponse"
+	default:
+		return "Other"
+	}
+}
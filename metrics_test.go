@@ -0,0 +1,84 @@
+package mailerlite_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/mailerlite/mailerlite-go"
+	"github.com/stretchr/testify/assert"
+)
+
+type recordingMetrics struct {
+	mu       sync.Mutex
+	requests []mailerlite.RequestMetrics
+}
+
+func (m *recordingMetrics) ObserveRequest(r mailerlite.RequestMetrics) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.requests = append(m.requests, r)
+}
+
+func TestMetricsObserveRequests(t *testing.T) {
+	calls := 0
+	testClient := NewTestClient(func(req *http.Request) *http.Response {
+		calls++
+		header := http.Header{}
+		header.Set(mailerlite.HeaderRateLimit, "120")
+		header.Set(mailerlite.HeaderRateRemaining, "100")
+		if calls == 2 {
+			return &http.Response{
+				StatusCode: http.StatusUnauthorized,
+				Request:    req,
+				Header:     header,
+				Body:       io.NopCloser(strings.NewReader(`{"message": "Unauthenticated."}`)),
+			}
+		}
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Request:    req,
+			Header:     header,
+			Body:       io.NopCloser(strings.NewReader(`{"data": []}`)),
+		}
+	})
+
+	metrics := &recordingMetrics{}
+	client := mailerlite.NewClient(testKey,
+		mailerlite.WithHTTPClient(testClient),
+		mailerlite.WithMetrics(metrics),
+	)
+
+	_, _, err := client.Field.List(context.TODO(), nil)
+	assert.NoError(t, err)
+
+	_, _, err = client.Group.List(context.TODO(), nil)
+	assert.Error(t, err)
+
+	assert.Equal(t, 2, len(metrics.requests))
+
+	assert.Equal(t, "Field.List", metrics.requests[0].Operation)
+	assert.Equal(t, http.MethodGet, metrics.requests[0].Method)
+	assert.Equal(t, http.StatusOK, metrics.requests[0].Status)
+	assert.Equal(t, 100, metrics.requests[0].Rate.Remaining)
+	assert.Equal(t, "", mailerlite.ErrorClass(metrics.requests[0].Err))
+
+	assert.Equal(t, "Group.List", metrics.requests[1].Operation)
+	assert.Equal(t, http.StatusUnauthorized, metrics.requests[1].Status)
+	assert.Equal(t, "AuthError", mailerlite.ErrorClass(metrics.requests[1].Err))
+}
+
+func TestErrorClass(t *testing.T) {
+	assert.Equal(t, "", mailerlite.ErrorClass(nil))
+	assert.Equal(t, "AuthError", mailerlite.ErrorClass(&mailerlite.AuthError{}))
+	assert.Equal(t, "RateLimitError", mailerlite.ErrorClass(&mailerlite.RateLimitError{}))
+	assert.Equal(t, "ErrorResponse", mailerlite.ErrorClass(&mailerlite.ErrorResponse{}))
+	assert.Equal(t, "NotFoundError", mailerlite.ErrorClass(&mailerlite.ErrorResponse{Response: &http.Response{StatusCode: http.StatusNotFound}}))
+	assert.Equal(t, "ValidationError", mailerlite.ErrorClass(&mailerlite.ErrorResponse{Response: &http.Response{StatusCode: http.StatusUnprocessableEntity}}))
+	assert.Equal(t, "ServerError", mailerlite.ErrorClass(&mailerlite.ErrorResponse{Response: &http.Response{StatusCode: http.StatusInternalServerError}}))
+	assert.Equal(t, "Other", mailerlite.ErrorClass(errors.New("connection refused")))
+}
@@ -0,0 +1,45 @@
+package mailerlite
+
+import "net/http"
+
+// Doer sends an API request. The returned error is already decoded into one
+// of the API error types, e.g. ErrorResponse, and on success the body of the
+// Response is left unread for the caller to decode.
+type Doer interface {
+	Do(req *http.Request) (*Response, error)
+}
+
+// DoerFunc is an adapter to allow the use of ordinary functions as Doer.
+type DoerFunc func(req *http.Request) (*Response, error)
+
+// Do calls f(req).
+func (f DoerFunc) Do(req *http.Request) (*Response, error) {
+	return f(req)
+}
+
+// Middleware wraps a Doer to add behavior around every API call made by the
+// client, e.g. header rewriting or audit logging. A middleware that reads the
+// body of a successful Response must replace it so it can still be decoded.
+type Middleware func(next Doer) Doer
+
+// WithMiddleware registers middleware on the client, see Client.Use.
+func WithMiddleware(middleware ...Middleware) ClientOption {
+	return func(c *Client) {
+		c.Use(middleware...)
+	}
+}
+
+// Use - Register middleware around every API call. The first registered
+// middleware is the outermost one. Use must not be called concurrently with requests.
+func (c *Client) Use(middleware ...Middleware) {
+	c.middleware = append(c.middleware, middleware...)
+}
+
+// doer returns the client's Doer wrapped in the registered middleware.
+func (c *Client) doer() Doer {
+	var doer Doer = DoerFunc(c.send)
+	for i := len(c.middleware) - 1; i >= 0; i-- {
+		doer = c.middleware[i](doer)
+	}
+	return doer
+}
@@ -0,0 +1,131 @@
+package mailerlite_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/mailerlite/mailerlite-go"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMiddlewareOrder(t *testing.T) {
+	var calls []string
+
+	trace := func(name string) mailerlite.Middleware {
+		return func(next mailerlite.Doer) mailerlite.Doer {
+			return mailerlite.DoerFunc(func(req *http.Request) (*mailerlite.Response, error) {
+				calls = append(calls, name+" before")
+				res, err := next.Do(req)
+				calls = append(calls, name+" after")
+				return res, err
+			})
+		}
+	}
+
+	testClient := NewTestClient(func(req *http.Request) *http.Response {
+		calls = append(calls, "request")
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Request:    req,
+			Body:       io.NopCloser(strings.NewReader(`{"data": [{"id": "1"}]}`)),
+		}
+	})
+
+	client := mailerlite.NewClient(testKey,
+		mailerlite.WithHTTPClient(testClient),
+		mailerlite.WithMiddleware(trace("first")),
+	)
+	client.Use(trace("second"))
+
+	timezones, _, err := client.Timezone.List(context.TODO())
+
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(timezones.Data))
+	assert.Equal(t, []string{"first before", "second before", "request", "second after", "first after"}, calls)
+}
+
+func TestMiddlewareCanRewriteRequest(t *testing.T) {
+	testClient := NewTestClient(func(req *http.Request) *http.Response {
+		assert.Equal(t, "tenant-1", req.Header.Get("X-Tenant"))
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Request:    req,
+			Body:       io.NopCloser(strings.NewReader(`{"data": []}`)),
+		}
+	})
+
+	client := mailerlite.NewClient(testKey, mailerlite.WithHTTPClient(testClient))
+	client.Use(func(next mailerlite.Doer) mailerlite.Doer {
+		return mailerlite.DoerFunc(func(req *http.Request) (*mailerlite.Response, error) {
+			req.Header.Set("X-Tenant", "tenant-1")
+			return next.Do(req)
+		})
+	})
+
+	_, _, err := client.Timezone.List(context.TODO())
+
+	assert.NoError(t, err)
+}
+
+func TestMiddlewareSeesDecodedErrors(t *testing.T) {
+	testClient := NewTestClient(func(req *http.Request) *http.Response {
+		return &http.Response{
+			StatusCode: http.StatusUnauthorized,
+			Request:    req,
+			Body:       io.NopCloser(strings.NewReader(`{"message": "Unauthenticated."}`)),
+		}
+	})
+
+	var seen error
+	client := mailerlite.NewClient(testKey, mailerlite.WithHTTPClient(testClient))
+	client.Use(func(next mailerlite.Doer) mailerlite.Doer {
+		return mailerlite.DoerFunc(func(req *http.Request) (*mailerlite.Response, error) {
+			res, err := next.Do(req)
+			seen = err
+			return res, err
+		})
+	})
+
+	_, _, err := client.Timezone.List(context.TODO())
+
+	var authErr *mailerlite.AuthError
+	assert.True(t, errors.As(seen, &authErr))
+	assert.Equal(t, "Unauthenticated.", authErr.Message)
+	assert.Equal(t, seen, err)
+}
+
+func TestMiddlewareSeesOperation(t *testing.T) {
+	testClient := NewTestClient(func(req *http.Request) *http.Response {
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Request:    req,
+			Body:       io.NopCloser(strings.NewReader(`{"data": {}}`)),
+		}
+	})
+
+	var operations []string
+	var attempts []int
+	client := mailerlite.NewClient(testKey, mailerlite.WithHTTPClient(testClient))
+	client.Use(func(next mailerlite.Doer) mailerlite.Doer {
+		return mailerlite.DoerFunc(func(req *http.Request) (*mailerlite.Response, error) {
+			operations = append(operations, mailerlite.Operation(req.Context()))
+			res, err := next.Do(req)
+			attempts = append(attempts, res.Attempts)
+			return res, err
+		})
+	})
+
+	_, _, err := client.Subscriber.Upsert(context.TODO(), &mailerlite.UpsertSubscriber{Email: "example@example.com"})
+	assert.NoError(t, err)
+
+	_, _, err = client.Group.Assign(context.TODO(), "1", "2")
+	assert.NoError(t, err)
+
+	assert.Equal(t, []string{"Subscriber.Upsert", "Group.Assign"}, operations)
+	assert.Equal(t, []int{1, 1}, attempts)
+	assert.Equal(t, "", mailerlite.Operation(context.TODO()))
+}
@@ -0,0 +1,65 @@
+package mailerlite
+
+import (
+	"context"
+	"runtime"
+	"strings"
+	"unicode"
+)
+
+type operationKey struct{}
+
+// Operation returns the name of the service operation that issued the API
+// call made with ctx, e.g. "Subscriber.Upsert". It is meant for middleware
+// naming spans or metrics, and returns an empty string outside of a call.
+func Operation(ctx context.Context) string {
+	operation, _ := ctx.Value(operationKey{}).(string)
+	return operation
+}
+
+func withOperation(ctx context.Context, operation string) context.Context {
+	if operation == "" {
+		return ctx
+	}
+	return context.WithValue(ctx, operationKey{}, operation)
+}
+
+// callerOperation returns the operation name of the function skip frames
+// above its caller, turning "mailerlite-go.(*subscriberService).Upsert"
+// into "Subscriber.Upsert".
+func callerOperation(skip int) string {
+	pc, _, _, ok := runtime.Caller(skip + 1)
+	if !ok {
+		return ""
+	}
+
+	fn := runtime.FuncForPC(pc)
+	if fn == nil {
+		return ""
+	}
+
+	return operationName(fn.Name())
+}
+
+func operationName(funcName string) string {
+	start := strings.LastIndex(funcName, "(*")
+	end := strings.LastIndex(funcName, ").")
+	if start < 0 || end < start {
+		return ""
+	}
+
+	receiver := strings.TrimSuffix(funcName[start+2:end], "Service")
+	method := funcName[end+2:]
+	if i := strings.Index(method, "."); i >= 0 {
+		// Closures defined in the method, e.g. "Upsert.func1".
+		method = method[:i]
+	}
+	if receiver == "" || method == "" {
+		return ""
+	}
+
+	runes := []rune(receiver)
+	runes[0] = unicode.ToUpper(runes[0])
+
+	return string(runes) + "." + method
+}
@@ -0,0 +1,90 @@
+package mailerlite
+
+import (
+	"errors"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+var errInvalidBaseURL = errors.New("base URL must be absolute")
+
+// ClientOption configures a Client created by NewClient.
+type ClientOption func(*Client)
+
+// WithBaseURL sets the base URL requests are sent to, e.g. a local stand-in
+// server or a regional proxy. An invalid URL is reported by every request.
+func WithBaseURL(baseURL string) ClientOption {
+	return func(c *Client) {
+		c.optionErr = c.SetBaseURL(baseURL)
+	}
+}
+
+// WithUserAgent sets the User-Agent header sent with every request.
+func WithUserAgent(userAgent string) ClientOption {
+	return func(c *Client) {
+		c.userAgent = userAgent
+	}
+}
+
+// WithHTTPClient sets the HTTP client used to communicate with the API. A nil
+// client keeps http.DefaultClient.
+func WithHTTPClient(client *http.Client) ClientOption {
+	return func(c *Client) {
+		if client == nil {
+			client = http.DefaultClient
+		}
+		c.client = client
+	}
+}
+
+// WithAPIVersion sets the API version sent in the X-Version header.
+func WithAPIVersion(version string) ClientOption {
+	return func(c *Client) {
+		c.apiVersion = version
+	}
+}
+
+// WithTimeout sets the timeout of the HTTP client. The client passed with
+// WithHTTPClient, or http.DefaultClient, is copied rather than modified.
+func WithTimeout(timeout time.Duration) ClientOption {
+	return func(c *Client) {
+		c.timeout = timeout
+	}
+}
+
+// WithRetryPolicy sets the policy used to retry failed requests.
+func WithRetryPolicy(policy *RetryPolicy) ClientOption {
+	return func(c *Client) {
+		c.SetRetryPolicy(policy)
+	}
+}
+
+// WithRateLimitWait makes requests wait for an exceeded rate limit to reset
+// instead of failing. A request still fails with the RateLimitError after
+// waiting 5 times.
+func WithRateLimitWait() ClientOption {
+	return func(c *Client) {
+		c.SetRateLimitWait(true)
+	}
+}
+
+// WithRateLimiter paces requests client side to stay within the last
+// observed rate limit.
+func WithRateLimiter() ClientOption {
+	return func(c *Client) {
+		c.SetRateLimiter(true)
+	}
+}
+
+func parseBaseURL(baseURL string) (*url.URL, error) {
+	u, err := url.Parse(strings.TrimRight(baseURL, "/"))
+	if err != nil {
+		return nil, err
+	}
+	if u.Scheme == "" || u.Host == "" {
+		return nil, &url.Error{Op: "parse", URL: baseURL, Err: errInvalidBaseURL}
+	}
+	return u, nil
+}
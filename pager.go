@@ -0,0 +1,244 @@
+package mailerlite
+
+import (
+	"context"
+)
+
+// Pager iterates over every item of a paginated list, fetching the next page
+// only once the items of the current one have been consumed.
+//
+//	pager := client.Group.All(ctx, &mailerlite.ListGroupOptions{Limit: 100})
+//	for pager.Next() {
+//		group := pager.Item()
+//		...
+//	}
+//	if err := pager.Err(); err != nil {
+//		...
+//	}
+//
+// Every page goes through the client, so it honors the retry policy and the
+// rate limit settings of the client. Iteration stops on the first error,
+// including the cancellation of the context given to the pager.
+//
+// A pager stopped before Next returned false must be closed, to cancel the
+// pages it prefetches.
+type Pager[T any] struct {
+	ctx    context.Context
+	cancel context.CancelFunc
+	fetch  pageFunc[T]
+	closed bool
+
+	items  []T
+	item   T
+	res    *Response
+	err    error
+	last   bool
+	cursor string
+
+	prefetch int
+}
+
+// pageFunc fetches the next page of a list, reporting whether it was the
+// last one.
+type pageFunc[T any] func(ctx context.Context) (items []T, res *Response, last bool, err error)
+
+func newPager[T any](ctx context.Context, fetch pageFunc[T]) *Pager[T] {
+	p := newPagerContext[T](ctx)
+	p.fetch = fetch
+	return p
+}
+
+// newPagerContext returns a Pager without a fetch function, whose context is
+// cancelled by Close.
+func newPagerContext[T any](ctx context.Context) *Pager[T] {
+	ctx, cancel := context.WithCancel(ctx)
+	return &Pager[T]{ctx: ctx, cancel: cancel}
+}
+
+// listFunc fetches the given page of a page numbered list.
+type listFunc[T any] func(ctx context.Context, page int) ([]T, *Links, *Meta, *Response, error)
+
+// pageResult is the outcome of a listFunc call.
+type pageResult[T any] struct {
+	page  int
+	items []T
+	links *Links
+	meta  *Meta
+	res   *Response
+	err   error
+}
+
+// newPagePager returns a Pager walking page numbers, starting at page or the
+// first page when it is not set.
+//
+// Once the first page tells how many pages there are, up to the prefetch
+// window of the pager is requested at once. Results are kept in a queue in
+// page order, so items are still returned in order and at most a window of
+// pages is held in memory.
+func newPagePager[T any](ctx context.Context, page int, list listFunc[T]) *Pager[T] {
+	if page < 1 {
+		page = 1
+	}
+
+	p := newPagerContext[T](ctx)
+
+	var (
+		next     = page
+		lastPage int
+		queue    []chan pageResult[T]
+	)
+
+	start := func(ctx context.Context, page int, async bool) chan pageResult[T] {
+		ch := make(chan pageResult[T], 1)
+		run := func() {
+			items, links, meta, res, err := list(ctx, page)
+			ch <- pageResult[T]{page: page, items: items, links: links, meta: meta, res: res, err: err}
+		}
+		if async {
+			go run()
+		} else {
+			run()
+		}
+		return ch
+	}
+
+	p.fetch = func(ctx context.Context) ([]T, *Response, bool, error) {
+		window := p.window()
+		for len(queue) == 0 || (lastPage > 0 && next <= lastPage && len(queue) < window) {
+			queue = append(queue, start(ctx, next, window > 1))
+			next++
+		}
+
+		r := <-queue[0]
+		queue = queue[1:]
+		if r.err != nil {
+			return nil, r.res, false, r.err
+		}
+
+		if r.meta.LastPage > 0 {
+			lastPage = r.meta.LastPage
+		}
+		last := r.links.IsLastPage()
+		if lastPage > 0 {
+			last = r.page >= lastPage
+		}
+
+		return r.items, r.res, last, nil
+	}
+
+	return p
+}
+
+// newCursorPager returns a Pager following the next_cursor of each page,
+// starting at *cursor. list fetches the page *cursor points to.
+func newCursorPager[T any](ctx context.Context, cursor *string, list func(ctx context.Context) ([]T, *Meta, *Response, error)) *Pager[T] {
+	p := newPagerContext[T](ctx)
+	p.fetch = func(ctx context.Context) ([]T, *Response, bool, error) {
+		p.cursor = *cursor
+
+		items, meta, res, err := list(ctx)
+		if err != nil {
+			return nil, res, false, err
+		}
+		*cursor = meta.NextCursor
+
+		return items, res, meta.NextCursor == "", nil
+	}
+	return p
+}
+
+// Next advances the pager to the next item, fetching a new page when needed.
+// It returns false when there are no more items or when fetching a page
+// failed, Err tells them apart. It returns false once the pager is closed.
+func (p *Pager[T]) Next() bool {
+	if p.closed {
+		return false
+	}
+
+	for len(p.items) == 0 {
+		if p.last || p.err != nil {
+			p.Close()
+			return false
+		}
+		if err := p.ctx.Err(); err != nil {
+			p.err = err
+			p.Close()
+			return false
+		}
+
+		items, res, last, err := p.fetch(p.ctx)
+		if res != nil {
+			p.res = res
+		}
+		if err != nil {
+			p.err = err
+			p.Close()
+			return false
+		}
+
+		p.items = items
+		p.last = last || len(items) == 0
+	}
+
+	p.item, p.items = p.items[0], p.items[1:]
+	return true
+}
+
+// Close stops the pager, cancelling the pages being prefetched. Next returns
+// false afterwards. Closing is only needed when the iteration is stopped
+// before Next returned false, closing again has no effect.
+func (p *Pager[T]) Close() {
+	p.closed = true
+	if p.cancel != nil {
+		p.cancel()
+	}
+}
+
+// Item returns the current item.
+func (p *Pager[T]) Item() T {
+	return p.item
+}
+
+// Err returns the error that stopped the iteration, if any.
+func (p *Pager[T]) Err() error {
+	return p.err
+}
+
+// Prefetch makes the pager request up to n pages at once, once the number of
+// pages of the list is known from the first one. Items are still returned in
+// order. The number of pages in flight is further limited by the remaining
+// requests of the rate limit reported by the last response.
+//
+// It only applies to page numbered lists and must be called before Next. Pages
+// being prefetched are cancelled by Close.
+func (p *Pager[T]) Prefetch(n int) *Pager[T] {
+	p.prefetch = n
+	return p
+}
+
+// window returns how many pages may be in flight at once.
+func (p *Pager[T]) window() int {
+	n := p.prefetch
+	if p.res != nil && p.res.Rate.Limit > 0 && p.res.Rate.Remaining < n {
+		n = p.res.Rate.Remaining
+	}
+	if n < 1 {
+		n = 1
+	}
+	return n
+}
+
+// Cursor returns the cursor of the page the current item belongs to, or of
+// the page that failed to load once Next returned false with an error. It is
+// empty for the first page and for lists not paginated with cursors.
+//
+// Saving it allows to resume an interrupted iteration by passing it back as
+// the starting cursor, at the cost of getting the items of that page again.
+func (p *Pager[T]) Cursor() string {
+	return p.cursor
+}
+
+// Response returns the response of the last page fetched.
+func (p *Pager[T]) Response() *Response {
+	return p.res
+}
@@ -0,0 +1,31 @@
+//go:build go1.23
+
+package mailerlite
+
+import "iter"
+
+// Items returns an iterator over the remaining items of the pager. When
+// fetching a page fails the error is yielded with a zero item, and the
+// iteration ends. Breaking out of the loop closes the pager.
+//
+//	for group, err := range client.Group.All(ctx, nil).Items() {
+//		if err != nil {
+//			return err
+//		}
+//		...
+//	}
+func (p *Pager[T]) Items() iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		for p.Next() {
+			if !yield(p.Item(), nil) {
+				p.Close()
+				return
+			}
+		}
+
+		if err := p.Err(); err != nil {
+			var zero T
+			yield(zero, err)
+		}
+	}
+}
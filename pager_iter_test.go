@@ -0,0 +1,39 @@
+//go:build go1.23
+
+package mailerlite_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/mailerlite/mailerlite-go"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPagerItems(t *testing.T) {
+	client := mailerlite.NewClient(testKey)
+
+	var pages []string
+	client.SetHttpClient(NewTestClient(pagedGroups(2, &pages)))
+
+	var ids []string
+	for group, err := range client.Group.All(context.TODO(), nil).Items() {
+		assert.NoError(t, err)
+		ids = append(ids, group.ID)
+	}
+
+	assert.Equal(t, []string{"1-1", "1-2", "2-1", "2-2"}, ids)
+}
+
+func TestPagerItemsYieldsError(t *testing.T) {
+	client := errorClient(http.StatusUnauthorized, `{"message": "Unauthenticated."}`)
+
+	var errs []error
+	for _, err := range client.Webhook.All(context.TODO(), nil).Items() {
+		errs = append(errs, err)
+	}
+
+	assert.Len(t, errs, 1)
+	assert.ErrorIs(t, errs[0], mailerlite.ErrUnauthorized)
+}
@@ -0,0 +1,364 @@
+package mailerlite_test
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/mailerlite/mailerlite-go"
+	"github.com/stretchr/testify/assert"
+)
+
+// pagedGroups serves lastPage pages of two groups each, recording the
+// requested page numbers.
+func pagedGroups(lastPage int, pages *[]string) RoundTripFunc {
+	return func(req *http.Request) *http.Response {
+		page := req.URL.Query().Get("page")
+		*pages = append(*pages, page)
+
+		next := ""
+		if page != fmt.Sprint(lastPage) {
+			next = "https://connect.mailerlite.com/api/groups?page=next"
+		}
+
+		body := fmt.Sprintf(`{"data": [{"id": "%[1]s-1"}, {"id": "%[1]s-2"}], "links": {"next": "%[2]s"}, "meta": {"current_page": %[1]s, "last_page": %[3]d}}`, page, next, lastPage)
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Request:    req,
+			Body:       io.NopCloser(strings.NewReader(body)),
+		}
+	}
+}
+
+func TestPagerFollowsPages(t *testing.T) {
+	client := mailerlite.NewClient(testKey)
+
+	var pages []string
+	client.SetHttpClient(NewTestClient(pagedGroups(3, &pages)))
+
+	pager := client.Group.All(context.TODO(), &mailerlite.ListGroupOptions{Limit: 2})
+
+	var ids []string
+	for pager.Next() {
+		ids = append(ids, pager.Item().ID)
+	}
+
+	assert.NoError(t, pager.Err())
+	assert.Equal(t, []string{"1", "2", "3"}, pages)
+	assert.Equal(t, []string{"1-1", "1-2", "2-1", "2-2", "3-1", "3-2"}, ids)
+	assert.Equal(t, http.StatusOK, pager.Response().StatusCode)
+}
+
+func TestPagerStartsAtPage(t *testing.T) {
+	client := mailerlite.NewClient(testKey)
+
+	var pages []string
+	client.SetHttpClient(NewTestClient(pagedGroups(3, &pages)))
+
+	options := &mailerlite.ListGroupOptions{Page: 2}
+	pager := client.Group.All(context.TODO(), options)
+	for pager.Next() {
+	}
+
+	assert.NoError(t, pager.Err())
+	assert.Equal(t, []string{"2", "3"}, pages)
+	assert.Equal(t, 2, options.Page)
+}
+
+func TestPagerStopsOnError(t *testing.T) {
+	client := mailerlite.NewClient(testKey)
+
+	calls := 0
+	client.SetHttpClient(NewTestClient(func(req *http.Request) *http.Response {
+		calls++
+		if calls == 2 {
+			return &http.Response{
+				StatusCode: http.StatusNotFound,
+				Request:    req,
+				Body:       io.NopCloser(strings.NewReader(`{"message": "Resource not found."}`)),
+			}
+		}
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Request:    req,
+			Body:       io.NopCloser(strings.NewReader(`{"data": [{"id": "1"}], "links": {"next": "next"}, "meta": {"last_page": 5}}`)),
+		}
+	}))
+
+	pager := client.Field.All(context.TODO(), nil)
+
+	items := 0
+	for pager.Next() {
+		items++
+	}
+
+	assert.Equal(t, 1, items)
+	assert.ErrorIs(t, pager.Err(), mailerlite.ErrNotFound)
+	assert.Equal(t, http.StatusNotFound, pager.Response().StatusCode)
+	assert.False(t, pager.Next())
+	assert.Equal(t, 2, calls)
+}
+
+func TestPagerHonorsContext(t *testing.T) {
+	client := mailerlite.NewClient(testKey)
+
+	var pages []string
+	client.SetHttpClient(NewTestClient(pagedGroups(3, &pages)))
+
+	ctx, cancel := context.WithCancel(context.TODO())
+	pager := client.Group.All(ctx, nil)
+
+	assert.True(t, pager.Next())
+	assert.True(t, pager.Next())
+	cancel()
+
+	assert.False(t, pager.Next())
+	assert.ErrorIs(t, pager.Err(), context.Canceled)
+	assert.Equal(t, []string{"1"}, pages)
+}
+
+func TestSegmentPagerFollowsLastSubscriber(t *testing.T) {
+	client := mailerlite.NewClient(testKey)
+
+	var afters []string
+	client.SetHttpClient(NewTestClient(func(req *http.Request) *http.Response {
+		after := req.URL.Query().Get("after")
+		afters = append(afters, after)
+
+		body := `{"data": [{"id": "1"}, {"id": "2"}], "meta": {"count": 2, "last": 2}}`
+		if after == "2" {
+			body = `{"data": [{"id": "3"}], "meta": {"count": 1, "last": 3}}`
+		}
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Request:    req,
+			Body:       io.NopCloser(strings.NewReader(body)),
+		}
+	}))
+
+	pager := client.Segment.AllSubscribers(context.TODO(), &mailerlite.ListSegmentSubscriberOptions{SegmentID: "1", Limit: 2})
+
+	var ids []string
+	for pager.Next() {
+		ids = append(ids, pager.Item().ID)
+	}
+
+	assert.NoError(t, pager.Err())
+	assert.Equal(t, []string{"1", "2", "3"}, ids)
+	assert.Equal(t, []string{"", "2"}, afters)
+}
+
+// cursorSubscribers serves three pages of subscribers linked with cursors,
+// recording the requests.
+func cursorSubscribers(requests *[]*http.Request) RoundTripFunc {
+	return func(req *http.Request) *http.Response {
+		*requests = append(*requests, req)
+
+		var body string
+		switch req.URL.Query().Get("cursor") {
+		case "":
+			body = `{"data": [{"id": "1"}, {"id": "2"}], "meta": {"next_cursor": "abc"}}`
+		case "abc":
+			body = `{"data": [{"id": "3"}, {"id": "4"}], "meta": {"next_cursor": "def", "prev_cursor": "abc"}}`
+		case "def":
+			body = `{"data": [{"id": "5"}], "meta": {"prev_cursor": "abc"}}`
+		}
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Request:    req,
+			Body:       io.NopCloser(strings.NewReader(body)),
+		}
+	}
+}
+
+func TestSubscriberPagerFollowsCursor(t *testing.T) {
+	client := mailerlite.NewClient(testKey)
+
+	var requests []*http.Request
+	client.SetHttpClient(NewTestClient(cursorSubscribers(&requests)))
+
+	pager := client.Subscriber.All(context.TODO(), &mailerlite.ListSubscriberOptions{
+		Filters: &[]mailerlite.Filter{{Name: "status", Value: "active"}},
+		Limit:   2,
+	})
+
+	var ids, cursors []string
+	for pager.Next() {
+		ids = append(ids, pager.Item().ID)
+		cursors = append(cursors, pager.Cursor())
+	}
+
+	assert.NoError(t, pager.Err())
+	assert.Equal(t, []string{"1", "2", "3", "4", "5"}, ids)
+	assert.Equal(t, []string{"", "", "abc", "abc", "def"}, cursors)
+	assert.Len(t, requests, 3)
+	for _, req := range requests {
+		assert.Equal(t, "active", req.URL.Query().Get("filter[status]"))
+		assert.Equal(t, "2", req.URL.Query().Get("limit"))
+	}
+}
+
+func TestSubscriberPagerResumesFromCursor(t *testing.T) {
+	client := mailerlite.NewClient(testKey)
+
+	var requests []*http.Request
+	client.SetHttpClient(NewTestClient(cursorSubscribers(&requests)))
+
+	pager := client.Subscriber.All(context.TODO(), &mailerlite.ListSubscriberOptions{Cursor: "abc"})
+
+	var ids []string
+	for pager.Next() {
+		ids = append(ids, pager.Item().ID)
+	}
+
+	assert.NoError(t, pager.Err())
+	assert.Equal(t, []string{"3", "4", "5"}, ids)
+	assert.Len(t, requests, 2)
+}
+
+func TestSubscriberPagerKeepsCursorOfFailedPage(t *testing.T) {
+	client := mailerlite.NewClient(testKey)
+
+	client.SetHttpClient(NewTestClient(func(req *http.Request) *http.Response {
+		if req.URL.Query().Get("cursor") == "abc" {
+			return &http.Response{
+				StatusCode: http.StatusInternalServerError,
+				Request:    req,
+				Body:       io.NopCloser(strings.NewReader(`{"message": "Server Error"}`)),
+			}
+		}
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Request:    req,
+			Body:       io.NopCloser(strings.NewReader(`{"data": [{"id": "1"}], "meta": {"next_cursor": "abc"}}`)),
+		}
+	}))
+
+	pager := client.Subscriber.All(context.TODO(), nil)
+	for pager.Next() {
+	}
+
+	assert.ErrorIs(t, pager.Err(), mailerlite.ErrServer)
+	assert.Equal(t, "abc", pager.Cursor())
+}
+
+// concurrentPages serves lastPage pages of campaign subscribers, later pages
+// answering faster, and tracks the maximum number of requests in flight.
+func concurrentPages(lastPage int, remaining string, maxInFlight *int32) RoundTripFunc {
+	var inFlight int32
+	return func(req *http.Request) *http.Response {
+		n := atomic.AddInt32(&inFlight, 1)
+		defer atomic.AddInt32(&inFlight, -1)
+		for {
+			max := atomic.LoadInt32(maxInFlight)
+			if n <= max || atomic.CompareAndSwapInt32(maxInFlight, max, n) {
+				break
+			}
+		}
+
+		page, _ := strconv.Atoi(req.URL.Query().Get("page"))
+		time.Sleep(time.Duration(lastPage-page) * 5 * time.Millisecond)
+
+		header := http.Header{}
+		header.Set(mailerlite.HeaderRateLimit, "120")
+		header.Set(mailerlite.HeaderRateRemaining, remaining)
+
+		body := fmt.Sprintf(`{"data": [{"id": "%[1]d-1"}, {"id": "%[1]d-2"}], "meta": {"current_page": %[1]d, "last_page": %[2]d}}`, page, lastPage)
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Request:    req,
+			Header:     header,
+			Body:       io.NopCloser(strings.NewReader(body)),
+		}
+	}
+}
+
+func TestPagerPrefetchKeepsOrder(t *testing.T) {
+	client := mailerlite.NewClient(testKey)
+
+	var maxInFlight int32
+	client.SetHttpClient(NewTestClient(concurrentPages(6, "100", &maxInFlight)))
+
+	pager := client.Campaign.AllSubscribers(context.TODO(), &mailerlite.ListCampaignSubscriberOptions{CampaignID: "1"}).Prefetch(3)
+
+	var ids []string
+	for pager.Next() {
+		ids = append(ids, pager.Item().ID)
+	}
+
+	assert.NoError(t, pager.Err())
+	assert.Equal(t, []string{"1-1", "1-2", "2-1", "2-2", "3-1", "3-2", "4-1", "4-2", "5-1", "5-2", "6-1", "6-2"}, ids)
+	assert.Greater(t, maxInFlight, int32(1))
+	assert.LessOrEqual(t, maxInFlight, int32(3))
+}
+
+func TestPagerPrefetchRespectsRateLimit(t *testing.T) {
+	client := mailerlite.NewClient(testKey)
+
+	var maxInFlight int32
+	client.SetHttpClient(NewTestClient(concurrentPages(4, "1", &maxInFlight)))
+
+	pager := client.Campaign.AllSubscribers(context.TODO(), &mailerlite.ListCampaignSubscriberOptions{CampaignID: "1"}).Prefetch(3)
+
+	items := 0
+	for pager.Next() {
+		items++
+	}
+
+	assert.NoError(t, pager.Err())
+	assert.Equal(t, 8, items)
+	assert.Equal(t, int32(1), maxInFlight)
+}
+
+func TestPagerCloseStopsPrefetch(t *testing.T) {
+	client := mailerlite.NewClient(testKey, mailerlite.WithRetryPolicy(&mailerlite.RetryPolicy{
+		MaxAttempts:          100,
+		BaseDelay:            5 * time.Millisecond,
+		MaxDelay:             5 * time.Millisecond,
+		RetryableStatusCodes: []int{http.StatusServiceUnavailable},
+	}))
+
+	// The first two pages load, the next ones keep failing and are retried.
+	var requests int32
+	client.SetHttpClient(NewTestClient(func(req *http.Request) *http.Response {
+		atomic.AddInt32(&requests, 1)
+
+		page, _ := strconv.Atoi(req.URL.Query().Get("page"))
+		if page > 2 {
+			return &http.Response{
+				StatusCode: http.StatusServiceUnavailable,
+				Request:    req,
+				Body:       io.NopCloser(strings.NewReader(`{"message": "Service Unavailable"}`)),
+			}
+		}
+
+		body := fmt.Sprintf(`{"data": [{"id": "%[1]d-1"}, {"id": "%[1]d-2"}], "meta": {"current_page": %[1]d, "last_page": 6}}`, page)
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Request:    req,
+			Body:       io.NopCloser(strings.NewReader(body)),
+		}
+	}))
+
+	pager := client.Campaign.AllSubscribers(context.TODO(), &mailerlite.ListCampaignSubscriberOptions{CampaignID: "1"}).Prefetch(3)
+
+	// Stop within the second page, while the next ones are prefetched.
+	for i := 0; i < 3; i++ {
+		assert.True(t, pager.Next())
+	}
+	pager.Close()
+	assert.False(t, pager.Next())
+	assert.NoError(t, pager.Err())
+
+	time.Sleep(20 * time.Millisecond)
+	stopped := atomic.LoadInt32(&requests)
+	time.Sleep(50 * time.Millisecond)
+
+	assert.Equal(t, stopped, atomic.LoadInt32(&requests))
+}
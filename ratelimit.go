@@ -0,0 +1,83 @@
+package mailerlite
+
+import (
+	"context"
+	"time"
+)
+
+// rateWindow is the period the API rate limit applies to.
+const rateWindow = time.Minute
+
+// tokenBucket paces requests so that they are spread evenly over the rate
+// limit window instead of bursting. It is seeded from the last observed Rate
+// and is not safe for concurrent use, callers hold Client.rateMu.
+type tokenBucket struct {
+	capacity float64   // capacity is the maximum number of tokens, the observed Rate.Limit.
+	tokens   float64   // tokens currently available.
+	last     time.Time // last time tokens were refilled.
+}
+
+// observe seeds the bucket from a rate returned by the API.
+func (b *tokenBucket) observe(rate Rate, now time.Time) {
+	if rate.Limit <= 0 {
+		return
+	}
+
+	if b.capacity == 0 {
+		b.tokens = float64(rate.Remaining)
+		b.last = now
+	}
+	b.capacity = float64(rate.Limit)
+
+	// The API knows better how many requests are left in the window.
+	if remaining := float64(rate.Remaining); remaining < b.tokens {
+		b.tokens = remaining
+	}
+}
+
+// reserve takes a token and returns how long the caller must wait before
+// using it. Tokens may go negative so that concurrent callers queue up behind
+// each other instead of all waking at once.
+func (b *tokenBucket) reserve(now time.Time) time.Duration {
+	// Nothing observed yet, so there is nothing to pace against.
+	if b.capacity == 0 {
+		return 0
+	}
+
+	perToken := rateWindow / time.Duration(b.capacity)
+
+	b.tokens += float64(now.Sub(b.last)) / float64(perToken)
+	if b.tokens > b.capacity {
+		b.tokens = b.capacity
+	}
+	b.last = now
+
+	b.tokens--
+	if b.tokens >= 0 {
+		return 0
+	}
+
+	return time.Duration(-b.tokens * float64(perToken))
+}
+
+// waitForToken blocks until the client side rate limiter allows a request.
+func (c *Client) waitForToken(ctx context.Context) error {
+	c.rateMu.Lock()
+	limiter := c.limiter
+	if limiter == nil {
+		c.rateMu.Unlock()
+		return nil
+	}
+	wait := limiter.reserve(time.Now())
+	c.rateMu.Unlock()
+
+	if err := sleep(ctx, wait); err != nil {
+		// Give the token back, the request is never sent.
+		c.rateMu.Lock()
+		limiter.tokens++
+		c.rateMu.Unlock()
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,214 @@
+package mailerlite_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/mailerlite/mailerlite-go"
+	"github.com/stretchr/testify/assert"
+)
+
+func rateLimitedResponse(req *http.Request, retryAfter string) *http.Response {
+	header := http.Header{}
+	header.Set(mailerlite.HeaderRateLimit, "120")
+	header.Set(mailerlite.HeaderRateRemaining, "0")
+	header.Set(mailerlite.HeaderRateRetryAfter, retryAfter)
+
+	return &http.Response{
+		StatusCode: http.StatusTooManyRequests,
+		Request:    req,
+		Header:     header,
+		Body:       io.NopCloser(strings.NewReader(`{"message": "Too Many Attempts."}`)),
+	}
+}
+
+func TestRateLimitWillExpire(t *testing.T) {
+	client := mailerlite.NewClient(testKey)
+
+	calls := 0
+	testClient := NewTestClient(func(req *http.Request) *http.Response {
+		calls++
+		if calls == 1 {
+			return rateLimitedResponse(req, "0")
+		}
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Request:    req,
+			Body:       io.NopCloser(strings.NewReader(`{"data": []}`)),
+		}
+	})
+
+	client.SetHttpClient(testClient)
+
+	_, _, err := client.Timezone.List(context.TODO())
+	assert.IsType(t, &mailerlite.RateLimitError{}, err)
+
+	_, res, err := client.Timezone.List(context.TODO())
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, res.StatusCode)
+	assert.Equal(t, 2, calls)
+}
+
+func TestRateLimitResetIsRecorded(t *testing.T) {
+	client := mailerlite.NewClient(testKey)
+
+	testClient := NewTestClient(func(req *http.Request) *http.Response {
+		return rateLimitedResponse(req, "30")
+	})
+
+	client.SetHttpClient(testClient)
+
+	before := time.Now()
+	_, _, err := client.Timezone.List(context.TODO())
+
+	rateErr, ok := err.(*mailerlite.RateLimitError)
+	assert.True(t, ok)
+	assert.WithinDuration(t, before.Add(30*time.Second), rateErr.Rate.Reset, time.Second)
+}
+
+func TestRateLimitErrorWithResetOnly(t *testing.T) {
+	client := mailerlite.NewClient(testKey)
+
+	reset := time.Now().Add(time.Minute).Truncate(time.Second)
+	calls := 0
+	testClient := NewTestClient(func(req *http.Request) *http.Response {
+		calls++
+		header := http.Header{}
+		header.Set(mailerlite.HeaderRateLimit, "120")
+		header.Set(mailerlite.HeaderRateRemaining, "0")
+		header.Set(mailerlite.HeaderRateReset, strconv.FormatInt(reset.Unix(), 10))
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Request:    req,
+			Header:     header,
+			Body:       io.NopCloser(strings.NewReader(`{"data": []}`)),
+		}
+	})
+
+	client.SetHttpClient(testClient)
+
+	_, _, err := client.Timezone.List(context.TODO())
+	assert.NoError(t, err)
+
+	_, _, err = client.Timezone.List(context.TODO())
+	assert.ErrorIs(t, err, mailerlite.ErrRateLimited)
+	assert.Equal(t, 1, calls)
+	assert.NotContains(t, err.Error(), "<nil>")
+	assert.Contains(t, err.Error(), reset.String())
+}
+
+func TestRateLimitWaitWillResend(t *testing.T) {
+	client := mailerlite.NewClient(testKey)
+	client.SetRateLimitWait(true)
+
+	calls := 0
+	testClient := NewTestClient(func(req *http.Request) *http.Response {
+		calls++
+		if calls == 1 {
+			return rateLimitedResponse(req, "1")
+		}
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Request:    req,
+			Body:       io.NopCloser(strings.NewReader(`{"data": []}`)),
+		}
+	})
+
+	client.SetHttpClient(testClient)
+
+	start := time.Now()
+	_, res, err := client.Timezone.List(context.TODO())
+
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, res.StatusCode)
+	assert.Equal(t, 2, calls)
+	assert.GreaterOrEqual(t, time.Since(start), 900*time.Millisecond)
+}
+
+func TestRateLimitWaitRespectsContext(t *testing.T) {
+	client := mailerlite.NewClient(testKey)
+	client.SetRateLimitWait(true)
+
+	calls := 0
+	testClient := NewTestClient(func(req *http.Request) *http.Response {
+		calls++
+		return rateLimitedResponse(req, "59")
+	})
+
+	client.SetHttpClient(testClient)
+
+	ctx, cancel := context.WithTimeout(context.TODO(), 10*time.Millisecond)
+	defer cancel()
+
+	_, _, err := client.Timezone.List(ctx)
+
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.Equal(t, 1, calls)
+}
+
+func TestRateLimitWaitIsBounded(t *testing.T) {
+	client := mailerlite.NewClient(testKey)
+	client.SetRateLimitWait(true)
+
+	calls := 0
+	testClient := NewTestClient(func(req *http.Request) *http.Response {
+		calls++
+		return rateLimitedResponse(req, "0")
+	})
+
+	client.SetHttpClient(testClient)
+
+	start := time.Now()
+	_, _, err := client.Timezone.List(context.TODO())
+
+	assert.IsType(t, &mailerlite.RateLimitError{}, err)
+	// The first attempt and 5 waits, of at least 250ms each.
+	assert.Equal(t, 6, calls)
+	assert.GreaterOrEqual(t, time.Since(start), 5*250*time.Millisecond)
+}
+
+func TestRateLimiterPacesConcurrentRequests(t *testing.T) {
+	client := mailerlite.NewClient(testKey)
+	client.SetRateLimiter(true)
+
+	testClient := NewTestClient(func(req *http.Request) *http.Response {
+		header := http.Header{}
+		// 6000 requests per minute allow one request every 10ms.
+		header.Set(mailerlite.HeaderRateLimit, "6000")
+		header.Set(mailerlite.HeaderRateRemaining, "0")
+
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Request:    req,
+			Header:     header,
+			Body:       io.NopCloser(strings.NewReader(`{"data": []}`)),
+		}
+	})
+
+	client.SetHttpClient(testClient)
+
+	// The first call seeds the limiter with an empty bucket.
+	_, _, err := client.Timezone.List(context.TODO())
+	assert.NoError(t, err)
+
+	start := time.Now()
+
+	var wg sync.WaitGroup
+	for i := 0; i < 5; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, _, err := client.Timezone.List(context.TODO())
+			assert.NoError(t, err)
+		}()
+	}
+	wg.Wait()
+
+	assert.GreaterOrEqual(t, time.Since(start), 40*time.Millisecond)
+}
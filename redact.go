@@ -0,0 +1,54 @@
+package mailerlite
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// RedactionRule replaces every match of Pattern with Replacement, expanded
+// as in regexp.Regexp.ReplaceAllString, before a value leaves the client in
+// logs or telemetry.
+type RedactionRule struct {
+	Pattern     *regexp.Regexp
+	Replacement string
+}
+
+var (
+	emailPattern  = regexp.MustCompile(`[A-Za-z0-9._%+\-]+(@|%40)[A-Za-z0-9.\-]+\.[A-Za-z]{2,}`)
+	bearerPattern = regexp.MustCompile(`Bearer\s+[^\s"]+`)
+)
+
+// DefaultRedactionRules returns rules redacting email addresses and bearer tokens.
+func DefaultRedactionRules() []RedactionRule {
+	return []RedactionRule{
+		{Pattern: bearerPattern, Replacement: "Bearer [REDACTED]"},
+		{Pattern: emailPattern, Replacement: "[REDACTED_EMAIL]"},
+	}
+}
+
+// RedactJSONFields returns a rule redacting the string values of the given
+// keys in JSON documents, e.g. subscriber fields such as "name" or "phone".
+func RedactJSONFields(keys ...string) RedactionRule {
+	quoted := make([]string, len(keys))
+	for i, key := range keys {
+		quoted[i] = regexp.QuoteMeta(key)
+	}
+
+	pattern := fmt.Sprintf(`"(%s)"\s*:\s*"(?:[^"\\]|\\.)*"`, strings.Join(quoted, "|"))
+
+	return RedactionRule{
+		Pattern:     regexp.MustCompile(pattern),
+		Replacement: `"$1":"[REDACTED]"`,
+	}
+}
+
+// redact applies rules to s.
+func redact(s string, rules []RedactionRule) string {
+	for _, rule := range rules {
+		if rule.Pattern != nil {
+			s = rule.Pattern.ReplaceAllString(s, rule.Replacement)
+		}
+	}
+	return s
+}
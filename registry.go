@@ -0,0 +1,198 @@
+package mailerlite
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// DefaultRegistryTTL is a reasonable time to keep the results of a Registry
+// for, groups, fields and segments seldom changing.
+const DefaultRegistryTTL = 5 * time.Minute
+
+// Registry resolves groups and segments by name and fields by key. It lists
+// them the first time they are needed and keeps the results for its TTL, so
+// configuration can refer to names rather than IDs:
+//
+//	registry := mailerlite.NewRegistry(client, mailerlite.DefaultRegistryTTL)
+//
+//	group, err := registry.EnsureGroup(ctx, "VIP customers")
+//	...
+//	_, _, err = client.Group.Assign(ctx, group.ID, subscriberID)
+//
+// Names are matched exactly. When several groups or segments share a name,
+// the first one listed is returned. A Registry is safe for concurrent use.
+type Registry struct {
+	client *Client
+	ttl    time.Duration
+
+	groups   *registryCache[Group]
+	fields   *registryCache[Field]
+	segments *registryCache[Segment]
+
+	// ensureMu keeps concurrent EnsureGroup calls from creating a group
+	// twice.
+	ensureMu sync.Mutex
+}
+
+// NewRegistry returns a registry using the client. With a ttl of zero or
+// less, results are kept until Refresh is called.
+func NewRegistry(client *Client, ttl time.Duration) *Registry {
+	return &Registry{
+		client: client,
+		ttl:    ttl,
+		groups: &registryCache[Group]{
+			kind: "group",
+			all: func(ctx context.Context) *Pager[Group] {
+				return client.Group.All(ctx, nil)
+			},
+			key: func(g Group) string { return g.Name },
+		},
+		fields: &registryCache[Field]{
+			kind: "field",
+			all: func(ctx context.Context) *Pager[Field] {
+				return client.Field.All(ctx, nil)
+			},
+			key: func(f Field) string { return f.Key },
+		},
+		segments: &registryCache[Segment]{
+			kind: "segment",
+			all: func(ctx context.Context) *Pager[Segment] {
+				return client.Segment.All(ctx, nil)
+			},
+			key: func(s Segment) string { return s.Name },
+		},
+	}
+}
+
+// GroupByName returns the group with the name. The error matches ErrNotFound
+// when there is none.
+func (r *Registry) GroupByName(ctx context.Context, name string) (*Group, error) {
+	group, _, err := r.groups.lookup(ctx, r.ttl, name)
+	return group, err
+}
+
+// FieldByKey returns the field with the key. The error matches ErrNotFound
+// when there is none.
+func (r *Registry) FieldByKey(ctx context.Context, key string) (*Field, error) {
+	field, _, err := r.fields.lookup(ctx, r.ttl, key)
+	return field, err
+}
+
+// SegmentByName returns the segment with the name. The error matches
+// ErrNotFound when there is none.
+func (r *Registry) SegmentByName(ctx context.Context, name string) (*Segment, error) {
+	segment, _, err := r.segments.lookup(ctx, r.ttl, name)
+	return segment, err
+}
+
+// EnsureGroup returns the group with the name, creating it if it does not
+// exist. Unless they were just listed, the groups are listed again before
+// creating one, in case it was created since they were last listed.
+func (r *Registry) EnsureGroup(ctx context.Context, name string) (*Group, error) {
+	r.ensureMu.Lock()
+	defer r.ensureMu.Unlock()
+
+	group, listed, err := r.groups.lookup(ctx, r.ttl, name)
+	if !errors.Is(err, ErrNotFound) {
+		return group, err
+	}
+
+	if !listed {
+		if err := r.groups.refresh(ctx); err != nil {
+			return nil, err
+		}
+		group, _, err = r.groups.lookup(ctx, r.ttl, name)
+		if !errors.Is(err, ErrNotFound) {
+			return group, err
+		}
+	}
+
+	root, _, err := r.client.Group.Create(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	r.groups.add(root.Data)
+	return &root.Data, nil
+}
+
+// Refresh lists the groups, fields and segments again.
+func (r *Registry) Refresh(ctx context.Context) error {
+	if err := r.groups.refresh(ctx); err != nil {
+		return err
+	}
+	if err := r.fields.refresh(ctx); err != nil {
+		return err
+	}
+	return r.segments.refresh(ctx)
+}
+
+// registryCache holds the items of a kind by key. The lock is held while
+// listing, so concurrent lookups wait for a single listing.
+type registryCache[T any] struct {
+	kind string
+	all  func(ctx context.Context) *Pager[T]
+	key  func(item T) string
+
+	mu       sync.Mutex
+	items    map[string]T
+	loadedAt time.Time
+}
+
+// lookup returns the item with the key, listing the items first if they were
+// not yet or if they expired. listed tells whether they were.
+func (c *registryCache[T]) lookup(ctx context.Context, ttl time.Duration, key string) (item *T, listed bool, err error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.items == nil || (ttl > 0 && time.Since(c.loadedAt) >= ttl) {
+		if err := c.load(ctx); err != nil {
+			return nil, true, err
+		}
+		listed = true
+	}
+
+	found, ok := c.items[key]
+	if !ok {
+		return nil, listed, fmt.Errorf("%w: %s %q", ErrNotFound, c.kind, key)
+	}
+	return &found, listed, nil
+}
+
+func (c *registryCache[T]) refresh(ctx context.Context) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.load(ctx)
+}
+
+// add adds an item created since the items were listed.
+func (c *registryCache[T]) add(item T) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.items != nil {
+		c.items[c.key(item)] = item
+	}
+}
+
+// load lists the items, c.mu being held. The items listed before are kept
+// if listing fails.
+func (c *registryCache[T]) load(ctx context.Context) error {
+	items := make(map[string]T)
+	pager := c.all(ctx)
+	for pager.Next() {
+		item := pager.Item()
+		if _, ok := items[c.key(item)]; !ok {
+			items[c.key(item)] = item
+		}
+	}
+	if err := pager.Err(); err != nil {
+		return err
+	}
+
+	c.items, c.loadedAt = items, time.Now()
+	return nil
+}
@@ -0,0 +1,132 @@
+package mailerlite_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/mailerlite/mailerlite-go"
+	"github.com/mailerlite/mailerlite-go/mailerlitetest"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRegistry(t *testing.T) {
+	server := mailerlitetest.NewServer()
+	defer server.Close()
+
+	client := server.Client()
+	created, _, err := client.Group.Create(context.TODO(), "VIP customers")
+	assert.NoError(t, err)
+	segment := server.AddSegment("Engaged")
+
+	registry := mailerlite.NewRegistry(client, 0)
+
+	group, err := registry.GroupByName(context.TODO(), "VIP customers")
+	assert.NoError(t, err)
+	assert.Equal(t, created.Data.ID, group.ID)
+
+	field, err := registry.FieldByKey(context.TODO(), "last_name")
+	assert.NoError(t, err)
+	assert.Equal(t, mailerlite.FieldTypeText, field.Type)
+
+	found, err := registry.SegmentByName(context.TODO(), "Engaged")
+	assert.NoError(t, err)
+	assert.Equal(t, segment.ID, found.ID)
+
+	_, err = registry.GroupByName(context.TODO(), "Missing")
+	assert.ErrorIs(t, err, mailerlite.ErrNotFound)
+
+	// The results are kept until Refresh.
+	requests := server.Requests()
+	_, _, err = client.Group.Create(context.TODO(), "Newsletter")
+	assert.NoError(t, err)
+
+	_, err = registry.GroupByName(context.TODO(), "Newsletter")
+	assert.ErrorIs(t, err, mailerlite.ErrNotFound)
+	_, err = registry.FieldByKey(context.TODO(), "name")
+	assert.NoError(t, err)
+	assert.Equal(t, requests+1, server.Requests())
+
+	assert.NoError(t, registry.Refresh(context.TODO()))
+	_, err = registry.GroupByName(context.TODO(), "Newsletter")
+	assert.NoError(t, err)
+}
+
+func TestRegistryTTL(t *testing.T) {
+	server := mailerlitetest.NewServer()
+	defer server.Close()
+
+	client := server.Client()
+	registry := mailerlite.NewRegistry(client, 20*time.Millisecond)
+
+	_, err := registry.GroupByName(context.TODO(), "Customers")
+	assert.ErrorIs(t, err, mailerlite.ErrNotFound)
+
+	_, _, err = client.Group.Create(context.TODO(), "Customers")
+	assert.NoError(t, err)
+	time.Sleep(30 * time.Millisecond)
+
+	_, err = registry.GroupByName(context.TODO(), "Customers")
+	assert.NoError(t, err)
+}
+
+func TestRegistryEnsureGroup(t *testing.T) {
+	server := mailerlitetest.NewServer()
+	defer server.Close()
+
+	client := server.Client()
+	registry := mailerlite.NewRegistry(client, 0)
+
+	existing, _, err := client.Group.Create(context.TODO(), "Customers")
+	assert.NoError(t, err)
+
+	group, err := registry.EnsureGroup(context.TODO(), "Customers")
+	assert.NoError(t, err)
+	assert.Equal(t, existing.Data.ID, group.ID)
+
+	// Once listed, the groups are listed again before creating one.
+	_, _, err = client.Group.Create(context.TODO(), "Newsletter")
+	assert.NoError(t, err)
+
+	requests := server.Requests()
+	group, err = registry.EnsureGroup(context.TODO(), "Newsletter")
+	assert.NoError(t, err)
+	assert.Equal(t, "Newsletter", group.Name)
+	assert.Equal(t, requests+1, server.Requests())
+
+	var wg sync.WaitGroup
+	ids := make([]string, 8)
+	for i := range ids {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			group, err := registry.EnsureGroup(context.TODO(), "VIP customers")
+			assert.NoError(t, err)
+			ids[i] = group.ID
+		}(i)
+	}
+	wg.Wait()
+
+	for _, id := range ids {
+		assert.Equal(t, ids[0], id)
+	}
+	assert.Len(t, server.Groups(), 3)
+
+	group, err = registry.GroupByName(context.TODO(), "VIP customers")
+	assert.NoError(t, err)
+	assert.Equal(t, ids[0], group.ID)
+}
+
+func TestRegistryEnsureGroupListsOnce(t *testing.T) {
+	server := mailerlitetest.NewServer()
+	defer server.Close()
+
+	registry := mailerlite.NewRegistry(server.Client(), 0)
+
+	// A cold registry lists the groups once, then creates the group.
+	group, err := registry.EnsureGroup(context.TODO(), "VIP customers")
+	assert.NoError(t, err)
+	assert.Equal(t, "VIP customers", group.Name)
+	assert.Equal(t, 2, server.Requests())
+}
@@ -0,0 +1,145 @@
+package mailerlite
+
+import (
+	"context"
+	"math/rand"
+	"net/http"
+	"time"
+)
+
+// RetryPolicy configures how Client retries requests that failed with a
+// transient error (network failure, 5xx or 429 response).
+type RetryPolicy struct {
+	// MaxAttempts is the total number of attempts, including the first one.
+	// Values below 2 disable retries.
+	MaxAttempts int
+
+	// BaseDelay is the delay before the first retry, doubled on every
+	// subsequent attempt.
+	BaseDelay time.Duration
+
+	// MaxDelay caps the computed backoff delay. It does not cap a delay
+	// requested by the API through the Retry-After header.
+	MaxDelay time.Duration
+
+	// Jitter is the fraction (0-1) of every backoff delay that is randomized.
+	Jitter float64
+
+	// RetryableStatusCodes lists the HTTP status codes that are retried.
+	RetryableStatusCodes []int
+
+	// RetryUpserts allows retrying POST requests made by upsert endpoints
+	// such as SubscriberService.Upsert. Other POST requests are never retried.
+	RetryUpserts bool
+}
+
+// DefaultRetryPolicy returns a RetryPolicy making up to 3 attempts for
+// 429 and 5xx responses.
+func DefaultRetryPolicy() *RetryPolicy {
+	return &RetryPolicy{
+		MaxAttempts: 3,
+		BaseDelay:   500 * time.Millisecond,
+		MaxDelay:    30 * time.Second,
+		Jitter:      0.2,
+		RetryableStatusCodes: []int{
+			http.StatusTooManyRequests,
+			http.StatusInternalServerError,
+			http.StatusBadGateway,
+			http.StatusServiceUnavailable,
+			http.StatusGatewayTimeout,
+		},
+	}
+}
+
+type upsertKey struct{}
+
+// withUpsert marks requests made with ctx as idempotent upserts.
+func withUpsert(ctx context.Context) context.Context {
+	return context.WithValue(ctx, upsertKey{}, true)
+}
+
+func isUpsert(ctx context.Context) bool {
+	upsert, _ := ctx.Value(upsertKey{}).(bool)
+	return upsert
+}
+
+// attempts returns how many times req may be sent under the policy.
+func (p *RetryPolicy) attempts(req *http.Request) int {
+	if p == nil || p.MaxAttempts < 2 {
+		return 1
+	}
+
+	switch req.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return p.MaxAttempts
+	case http.MethodPost:
+		if p.RetryUpserts && isUpsert(req.Context()) {
+			return p.MaxAttempts
+		}
+	}
+
+	return 1
+}
+
+func (p *RetryPolicy) retryableStatus(code int) bool {
+	for _, c := range p.RetryableStatusCodes {
+		if c == code {
+			return true
+		}
+	}
+	return false
+}
+
+// delay returns how long to wait before the given retry (starting at 1).
+// A Retry-After value sent by the API takes precedence over the backoff.
+func (p *RetryPolicy) delay(retry int, rate Rate) time.Duration {
+	if rate.RetryAfter != nil && *rate.RetryAfter > 0 {
+		return *rate.RetryAfter
+	}
+
+	d := p.BaseDelay
+	for i := 1; i < retry && (p.MaxDelay <= 0 || d < p.MaxDelay); i++ {
+		d *= 2
+	}
+	if p.MaxDelay > 0 && d > p.MaxDelay {
+		d = p.MaxDelay
+	}
+
+	if p.Jitter > 0 {
+		d -= time.Duration(p.Jitter * rand.Float64() * float64(d))
+	}
+
+	return d
+}
+
+// rewindBody resets the body of req so that it can be sent again.
+func rewindBody(req *http.Request) error {
+	if req.GetBody == nil {
+		return nil
+	}
+
+	body, err := req.GetBody()
+	if err != nil {
+		return err
+	}
+	req.Body = body
+
+	return nil
+}
+
+// sleep waits for d, returning early with the context error if ctx is done.
+func sleep(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
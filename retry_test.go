@@ -0,0 +1,162 @@
+package mailerlite_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/mailerlite/mailerlite-go"
+	"github.com/stretchr/testify/assert"
+)
+
+func testRetryPolicy() *mailerlite.RetryPolicy {
+	policy := mailerlite.DefaultRetryPolicy()
+	policy.BaseDelay = time.Millisecond
+	policy.MaxDelay = 5 * time.Millisecond
+	return policy
+}
+
+func TestWillRetryServerError(t *testing.T) {
+	client := mailerlite.NewClient(testKey)
+	client.SetRetryPolicy(testRetryPolicy())
+
+	calls := 0
+	testClient := NewTestClient(func(req *http.Request) *http.Response {
+		calls++
+		if calls < 3 {
+			return &http.Response{
+				StatusCode: http.StatusBadGateway,
+				Request:    req,
+				Body:       io.NopCloser(strings.NewReader(`{"message": "Bad Gateway"}`)),
+			}
+		}
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Request:    req,
+			Body:       io.NopCloser(strings.NewReader(`{"data": [{"id": "1"}]}`)),
+		}
+	})
+
+	client.SetHttpClient(testClient)
+
+	timezones, res, err := client.Timezone.List(context.TODO())
+
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, res.StatusCode)
+	assert.Equal(t, 1, len(timezones.Data))
+	assert.Equal(t, 3, calls)
+}
+
+func TestWillStopRetryingAfterMaxAttempts(t *testing.T) {
+	client := mailerlite.NewClient(testKey)
+	client.SetRetryPolicy(testRetryPolicy())
+
+	calls := 0
+	testClient := NewTestClient(func(req *http.Request) *http.Response {
+		calls++
+		return &http.Response{
+			StatusCode: http.StatusServiceUnavailable,
+			Request:    req,
+			Body:       io.NopCloser(strings.NewReader(`{"message": "Service Unavailable"}`)),
+		}
+	})
+
+	client.SetHttpClient(testClient)
+
+	_, res, err := client.Timezone.List(context.TODO())
+
+	assert.Error(t, err)
+	assert.Equal(t, http.StatusServiceUnavailable, res.StatusCode)
+	assert.Equal(t, 3, calls)
+}
+
+func TestWillNotRetryPost(t *testing.T) {
+	client := mailerlite.NewClient(testKey)
+	client.SetRetryPolicy(testRetryPolicy())
+
+	calls := 0
+	testClient := NewTestClient(func(req *http.Request) *http.Response {
+		calls++
+		return &http.Response{
+			StatusCode: http.StatusInternalServerError,
+			Request:    req,
+			Body:       io.NopCloser(strings.NewReader(`{"message": "Server Error"}`)),
+		}
+	})
+
+	client.SetHttpClient(testClient)
+
+	_, _, err := client.Group.Create(context.TODO(), "group")
+	assert.Error(t, err)
+	assert.Equal(t, 1, calls)
+
+	calls = 0
+	_, _, err = client.Subscriber.Upsert(context.TODO(), &mailerlite.UpsertSubscriber{Email: "example@example.com"})
+	assert.Error(t, err)
+	assert.Equal(t, 1, calls)
+}
+
+func TestWillRetryUpsertWhenEnabled(t *testing.T) {
+	client := mailerlite.NewClient(testKey)
+
+	policy := testRetryPolicy()
+	policy.RetryUpserts = true
+	client.SetRetryPolicy(policy)
+
+	var bodies []string
+	testClient := NewTestClient(func(req *http.Request) *http.Response {
+		body, _ := io.ReadAll(req.Body)
+		bodies = append(bodies, string(body))
+		if len(bodies) == 1 {
+			return &http.Response{
+				StatusCode: http.StatusInternalServerError,
+				Request:    req,
+				Body:       io.NopCloser(strings.NewReader(`{"message": "Server Error"}`)),
+			}
+		}
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Request:    req,
+			Body:       io.NopCloser(strings.NewReader(`{"data": {"email": "example@example.com"}}`)),
+		}
+	})
+
+	client.SetHttpClient(testClient)
+
+	subscriber, _, err := client.Subscriber.Upsert(context.TODO(), &mailerlite.UpsertSubscriber{Email: "example@example.com"})
+
+	assert.NoError(t, err)
+	assert.Equal(t, "example@example.com", subscriber.Data.Email)
+	assert.Equal(t, 2, len(bodies))
+	assert.Equal(t, bodies[0], bodies[1])
+	assert.Contains(t, bodies[1], "example@example.com")
+}
+
+func TestWillStopRetryingWhenContextIsDone(t *testing.T) {
+	client := mailerlite.NewClient(testKey)
+
+	policy := testRetryPolicy()
+	policy.BaseDelay = time.Minute
+	policy.MaxDelay = time.Minute
+	client.SetRetryPolicy(policy)
+
+	testClient := NewTestClient(func(req *http.Request) *http.Response {
+		return &http.Response{
+			StatusCode: http.StatusBadGateway,
+			Request:    req,
+			Body:       io.NopCloser(strings.NewReader(`{"message": "Bad Gateway"}`)),
+		}
+	})
+
+	client.SetHttpClient(testClient)
+
+	ctx, cancel := context.WithTimeout(context.TODO(), 10*time.Millisecond)
+	defer cancel()
+
+	_, _, err := client.Timezone.List(ctx)
+
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+}
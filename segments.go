@@ -11,9 +11,11 @@ const segmentEndpoint = "/segments"
 // SegmentService defines an interface for segment-related operations.
 type SegmentService interface {
 	List(ctx context.Context, options *ListSegmentOptions) (*RootSegments, *Response, error)
+	All(ctx context.Context, options *ListSegmentOptions) *Pager[Segment]
 	Update(ctx context.Context, segmentID, segmentName string) (*RootSegment, *Response, error)
 	Delete(ctx context.Context, segmentID string) (*Response, error)
 	Subscribers(ctx context.Context, options *ListSegmentSubscriberOptions) (*RootSubscribers, *Response, error)
+	AllSubscribers(ctx context.Context, options *ListSegmentSubscriberOptions) *Pager[Subscriber]
 }
 
 // segmentService implements SegmentService.
@@ -37,7 +39,7 @@ type Segment struct {
 	Total     int       `json:"total"`
 	OpenRate  OpenRate  `json:"open_rate"`
 	ClickRate ClickRate `json:"click_rate"`
-	CreatedAt string    `json:"created_at"`
+	CreatedAt Timestamp `json:"created_at"`
 }
 
 // ListSegmentOptions - modifies the behavior of SegmentService.List method
@@ -69,6 +71,25 @@ func (s *segmentService) List(ctx context.Context, options *ListSegmentOptions)
 	return root, res, nil
 }
 
+// All - returns a Pager over all the segments, starting at options.Page
+func (s *segmentService) All(ctx context.Context, options *ListSegmentOptions) *Pager[Segment] {
+	opts := ListSegmentOptions{}
+	if options != nil {
+		opts = *options
+	}
+
+	return newPagePager(ctx, opts.Page, func(ctx context.Context, page int) ([]Segment, *Links, *Meta, *Response, error) {
+		pageOpts := opts
+		pageOpts.Page = page
+
+		root, res, err := s.List(ctx, &pageOpts)
+		if err != nil {
+			return nil, nil, nil, res, err
+		}
+		return root.Data, &root.Links, &root.Meta, res, nil
+	})
+}
+
 func (s *segmentService) Update(ctx context.Context, segmentID, segmentName string) (*RootSegment, *Response, error) {
 	body := map[string]interface{}{"name": segmentName}
 	path := fmt.Sprintf("%s/%s", segmentEndpoint, segmentID)
@@ -119,3 +140,24 @@ func (s *segmentService) Subscribers(ctx context.Context, options *ListSegmentSu
 
 	return root, res, nil
 }
+
+// AllSubscribers - returns a Pager over all the subscribers of a segment, following the
+// ID of the last subscriber of each page and starting after options.After
+func (s *segmentService) AllSubscribers(ctx context.Context, options *ListSegmentSubscriberOptions) *Pager[Subscriber] {
+	opts := ListSegmentSubscriberOptions{}
+	if options != nil {
+		opts = *options
+	}
+
+	return newPager(ctx, func(ctx context.Context) ([]Subscriber, *Response, bool, error) {
+		root, res, err := s.Subscribers(ctx, &opts)
+		if err != nil {
+			return nil, res, false, err
+		}
+
+		last := root.Meta.Last == 0 || (opts.Limit > 0 && len(root.Data) < opts.Limit)
+		opts.After = root.Meta.Last
+
+		return root.Data, res, last, nil
+	})
+}
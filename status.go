@@ -0,0 +1,112 @@
+package mailerlite
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+// SubscriberStatus is the status of a subscriber.
+type SubscriberStatus string
+
+const (
+	SubscriberStatusActive       SubscriberStatus = "active"
+	SubscriberStatusUnsubscribed SubscriberStatus = "unsubscribed"
+	SubscriberStatusUnconfirmed  SubscriberStatus = "unconfirmed"
+	SubscriberStatusBounced      SubscriberStatus = "bounced"
+	SubscriberStatusJunk         SubscriberStatus = "junk"
+)
+
+var (
+	// ErrInvalidStatus is returned when sending a subscriber status the API
+	// does not accept.
+	ErrInvalidStatus = errors.New("mailerlite: invalid subscriber status")
+
+	// ErrStatusTransition is matched by a StatusTransitionError.
+	ErrStatusTransition = errors.New("mailerlite: refused subscriber status transition")
+)
+
+// refusedTransitions lists, per status, the statuses the API refuses to move
+// a subscriber to.
+var refusedTransitions = map[SubscriberStatus][]SubscriberStatus{
+	SubscriberStatusBounced: {SubscriberStatusActive, SubscriberStatusUnconfirmed},
+	SubscriberStatusJunk:    {SubscriberStatusActive, SubscriberStatusUnconfirmed},
+}
+
+// Valid tells whether the status is one of the statuses of the API.
+func (s SubscriberStatus) Valid() bool {
+	switch s {
+	case SubscriberStatusActive, SubscriberStatusUnsubscribed, SubscriberStatusUnconfirmed,
+		SubscriberStatusBounced, SubscriberStatusJunk:
+		return true
+	}
+	return false
+}
+
+// CanTransitionTo tells whether a subscriber with the status can be moved to
+// the status to. Bounced and junk subscribers can not be made active or
+// unconfirmed again.
+func (s SubscriberStatus) CanTransitionTo(to SubscriberStatus) bool {
+	for _, refused := range refusedTransitions[s] {
+		if refused == to {
+			return false
+		}
+	}
+	return true
+}
+
+// checkStatus returns ErrInvalidStatus for a status the API does not accept,
+// so that requests setting it are rejected before being sent. An empty
+// status leaves the status unchanged. Statuses are not checked when decoding
+// or encoding, statuses added to the API later are kept as they are.
+func checkStatus(status SubscriberStatus) error {
+	if status != "" && !status.Valid() {
+		return fmt.Errorf("%w %q", ErrInvalidStatus, string(status))
+	}
+	return nil
+}
+
+// FilterByStatus returns the filters listing the subscribers with the
+// status, for ListSubscriberOptions.Filters.
+func FilterByStatus(status SubscriberStatus) *[]Filter {
+	return &[]Filter{{Name: "status", Value: string(status)}}
+}
+
+// StatusTransitionError occurs when a subscriber is moved to a status the API
+// refuses for its current status.
+type StatusTransitionError struct {
+	SubscriberID string
+	From         SubscriberStatus
+	To           SubscriberStatus
+}
+
+func (e *StatusTransitionError) Error() string {
+	return fmt.Sprintf("mailerlite: subscriber %s can not be moved from %s to %s", e.SubscriberID, e.From, e.To)
+}
+
+// Is matches ErrStatusTransition.
+func (e *StatusTransitionError) Is(target error) bool {
+	return target == ErrStatusTransition
+}
+
+// SetStatus - sets the status of the subscriber, checking first that the API accepts moving it from its
+// current status. A refused transition returns a *StatusTransitionError without updating the subscriber.
+func (s *subscriberService) SetStatus(ctx context.Context, subscriberID string, status SubscriberStatus) (*RootSubscriber, *Response, error) {
+	if !status.Valid() {
+		return nil, nil, fmt.Errorf("%w %q", ErrInvalidStatus, string(status))
+	}
+
+	current, res, err := s.Get(ctx, &GetSubscriberOptions{SubscriberID: subscriberID})
+	if err != nil {
+		return nil, res, err
+	}
+
+	if !current.Data.Status.CanTransitionTo(status) {
+		return nil, res, &StatusTransitionError{SubscriberID: subscriberID, From: current.Data.Status, To: status}
+	}
+	if current.Data.Status == status {
+		return current, res, nil
+	}
+
+	return s.Update(ctx, &UpdateSubscriber{ID: subscriberID, Status: status})
+}
@@ -0,0 +1,138 @@
+package mailerlite_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/mailerlite/mailerlite-go"
+	"github.com/mailerlite/mailerlite-go/mailerlitetest"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSubscriberStatus(t *testing.T) {
+	assert.True(t, mailerlite.SubscriberStatusJunk.Valid())
+	assert.False(t, mailerlite.SubscriberStatus("deleted").Valid())
+
+	assert.True(t, mailerlite.SubscriberStatusUnsubscribed.CanTransitionTo(mailerlite.SubscriberStatusActive))
+	assert.True(t, mailerlite.SubscriberStatusBounced.CanTransitionTo(mailerlite.SubscriberStatusUnsubscribed))
+	assert.False(t, mailerlite.SubscriberStatusBounced.CanTransitionTo(mailerlite.SubscriberStatusActive))
+	assert.False(t, mailerlite.SubscriberStatusJunk.CanTransitionTo(mailerlite.SubscriberStatusUnconfirmed))
+}
+
+func TestUpsertInvalidStatus(t *testing.T) {
+	client := mailerlite.NewClient(testKey)
+	client.SetHttpClient(NewTestClient(func(req *http.Request) *http.Response {
+		t.Fatal("the request should not be sent")
+		return nil
+	}))
+
+	_, _, err := client.Subscriber.Upsert(context.TODO(), &mailerlite.UpsertSubscriber{Email: "example@example.com", Status: "deleted"})
+	assert.ErrorIs(t, err, mailerlite.ErrInvalidStatus)
+}
+
+func TestSubscriberStatusEncoding(t *testing.T) {
+	// Statuses added to the API later are kept as they are.
+	b, err := json.Marshal(mailerlite.Subscriber{Email: "example@example.com", Status: "pending"})
+	assert.NoError(t, err)
+	assert.Contains(t, string(b), `"status":"pending"`)
+
+	var subscriber mailerlite.Subscriber
+	assert.NoError(t, json.Unmarshal(b, &subscriber))
+	assert.Equal(t, mailerlite.SubscriberStatus("pending"), subscriber.Status)
+}
+
+func TestUpsertManyInvalidStatus(t *testing.T) {
+	for name, opts := range map[string][]mailerlite.BulkOption{
+		"requests": nil,
+		"batches":  {mailerlite.WithBatching()},
+	} {
+		t.Run(name, func(t *testing.T) {
+			server := mailerlitetest.NewServer()
+			defer server.Close()
+
+			report, err := server.Client().Subscriber.UpsertMany(context.TODO(), []*mailerlite.UpsertSubscriber{
+				{Email: "a@example.com"},
+				{Email: "c@example.com", Status: "Active"},
+			}, opts...)
+
+			assert.NoError(t, err)
+			assert.Equal(t, 1, report.Created)
+			assert.Equal(t, 1, report.Failed)
+			assert.Equal(t, mailerlite.UpsertCreated, report.Results[0].Status)
+			assert.ErrorIs(t, report.Results[1].Err, mailerlite.ErrInvalidStatus)
+		})
+	}
+}
+
+func TestUpsertNilSubscriber(t *testing.T) {
+	for name, opts := range map[string][]mailerlite.BulkOption{
+		"requests": nil,
+		"batches":  {mailerlite.WithBatching()},
+	} {
+		t.Run(name, func(t *testing.T) {
+			server := mailerlitetest.NewServer()
+			defer server.Close()
+
+			report, err := server.Client().Subscriber.UpsertMany(context.TODO(), []*mailerlite.UpsertSubscriber{
+				{Email: "a@example.com"},
+				nil,
+			}, opts...)
+
+			assert.NoError(t, err)
+			assert.Equal(t, 1, report.Created)
+			assert.Equal(t, 1, report.Failed)
+			assert.Equal(t, mailerlite.UpsertFailed, report.Results[1].Status)
+			assert.ErrorIs(t, report.Results[1].Err, mailerlite.ErrEmptySubscriber)
+		})
+	}
+
+	server := mailerlitetest.NewServer()
+	defer server.Close()
+
+	assert.NotPanics(t, func() {
+		_, _, _ = server.Client().Subscriber.Upsert(context.TODO(), nil)
+	})
+}
+
+func TestSetStatus(t *testing.T) {
+	server := mailerlitetest.NewServer()
+	defer server.Close()
+
+	client := server.Client()
+	for _, upsert := range []*mailerlite.UpsertSubscriber{
+		{Email: "active@example.com"},
+		{Email: "bounced@example.com", Status: mailerlite.SubscriberStatusBounced},
+	} {
+		_, _, err := client.Subscriber.Upsert(context.TODO(), upsert)
+		assert.NoError(t, err)
+	}
+
+	bounced := client.Subscriber.All(context.TODO(), &mailerlite.ListSubscriberOptions{
+		Filters: mailerlite.FilterByStatus(mailerlite.SubscriberStatusBounced),
+	})
+	assert.True(t, bounced.Next())
+	subscriber := bounced.Item()
+	assert.Equal(t, "bounced@example.com", subscriber.Email)
+	assert.False(t, bounced.Next())
+	assert.NoError(t, bounced.Err())
+
+	_, _, err := client.Subscriber.SetStatus(context.TODO(), subscriber.ID, mailerlite.SubscriberStatusActive)
+
+	var transitionErr *mailerlite.StatusTransitionError
+	assert.ErrorAs(t, err, &transitionErr)
+	assert.ErrorIs(t, err, mailerlite.ErrStatusTransition)
+	assert.Equal(t, mailerlite.SubscriberStatusBounced, transitionErr.From)
+	assert.Equal(t, mailerlite.SubscriberStatusActive, transitionErr.To)
+
+	root, _, err := client.Subscriber.SetStatus(context.TODO(), subscriber.ID, mailerlite.SubscriberStatusUnsubscribed)
+	assert.NoError(t, err)
+	assert.Equal(t, mailerlite.SubscriberStatusUnsubscribed, root.Data.Status)
+	assert.False(t, root.Data.UnsubscribedAt.IsZero())
+
+	_, _, err = client.Subscriber.Upsert(context.TODO(), &mailerlite.UpsertSubscriber{Email: "bounced@example.com", Status: mailerlite.SubscriberStatusBounced})
+	assert.NoError(t, err)
+	_, _, err = client.Subscriber.Upsert(context.TODO(), &mailerlite.UpsertSubscriber{Email: "bounced@example.com", Status: mailerlite.SubscriberStatusActive})
+	assert.ErrorIs(t, err, mailerlite.ErrValidation)
+}
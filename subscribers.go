@@ -3,6 +3,7 @@ package mailerlite
 import (
 	"context"
 	"fmt"
+	"io"
 	"net/http"
 )
 
@@ -11,16 +12,24 @@ const subscriberEndpoint = "/subscribers"
 // SubscriberService defines an interface for subscriber-related operations.
 type SubscriberService interface {
 	List(ctx context.Context, options *ListSubscriberOptions) (*RootSubscribers, *Response, error)
+	All(ctx context.Context, options *ListSubscriberOptions) *Pager[Subscriber]
+	Export(ctx context.Context, w io.Writer, options *ExportOptions) (*ExportCheckpoint, error)
 	Count(ctx context.Context) (*Count, *Response, error)
 	Get(ctx context.Context, options *GetSubscriberOptions) (*RootSubscriber, *Response, error)
 	// Deprecated: use Upsert instead (https://github.com/mailerlite/mailerlite-go/issues/17)
 	Create(ctx context.Context, subscriber *Subscriber) (*RootSubscriber, *Response, error)
 	Upsert(ctx context.Context, subscriber *UpsertSubscriber) (*RootSubscriber, *Response, error)
+	UpsertMany(ctx context.Context, subscribers []*UpsertSubscriber, opts ...BulkOption) (*UpsertReport, error)
+	UpsertStream(ctx context.Context, subscribers <-chan *UpsertSubscriber, opts ...BulkOption) <-chan UpsertResult
 	Update(ctx context.Context, subscriber *UpdateSubscriber) (*RootSubscriber, *Response, error)
+	SetStatus(ctx context.Context, subscriberID string, status SubscriberStatus) (*RootSubscriber, *Response, error)
 	Delete(ctx context.Context, subscriberID string) (*Response, error)
 	Forget(ctx context.Context, subscriberID string) (*RootSubscriber, *Response, error)
 	ActivityLog(ctx context.Context, options *ListActivityOptions) (*RootActivityLog, *Response, error)
+	AllActivity(ctx context.Context, options *ListActivityOptions) *Pager[ActivityEntry]
 	GetImport(ctx context.Context, importID string) (*RootImport, *Response, error)
+	WaitForImport(ctx context.Context, importID string, opts ...WaitOption) (*Import, error)
+	WaitForImportURL(ctx context.Context, progressURL string, opts ...WaitOption) (*Import, error)
 }
 
 // subscriberService implements SubscriberService.
@@ -47,7 +56,7 @@ type Count struct {
 type Subscriber struct {
 	ID             string                 `json:"id,omitempty"`
 	Email          string                 `json:"email,omitempty"`
-	Status         string                 `json:"status,omitempty"`
+	Status         SubscriberStatus       `json:"status,omitempty"`
 	Source         string                 `json:"source,omitempty"`
 	Sent           int                    `json:"sent,omitempty"`
 	OpensCount     int                    `json:"opens_count,omitempty"`
@@ -55,22 +64,61 @@ type Subscriber struct {
 	OpenRate       float64                `json:"open_rate,omitempty"`
 	ClickRate      float64                `json:"click_rate,omitempty"`
 	IPAddress      interface{}            `json:"ip_address,omitempty"`
-	SubscribedAt   string                 `json:"subscribed_at,omitempty"`
-	UnsubscribedAt interface{}            `json:"unsubscribed_at,omitempty"`
-	CreatedAt      string                 `json:"created_at,omitempty"`
-	UpdatedAt      string                 `json:"updated_at,omitempty"`
+	SubscribedAt   Timestamp              `json:"subscribed_at"`
+	UnsubscribedAt Timestamp              `json:"unsubscribed_at"`
+	CreatedAt      Timestamp              `json:"created_at"`
+	UpdatedAt      Timestamp              `json:"updated_at"`
 	Fields         map[string]interface{} `json:"fields,omitempty"`
 	Groups         []Group                `json:"groups,omitempty"`
-	OptedInAt      string                 `json:"opted_in_at,omitempty"`
+	OptedInAt      Timestamp              `json:"opted_in_at"`
 	OptinIP        string                 `json:"optin_ip,omitempty"`
 }
 
+// createSubscriberBody is the body of SubscriberService.Create. It encodes
+// timestamps as the request did when they were strings: unset ones are left
+// out, and those of groups are empty strings.
+type createSubscriberBody struct {
+	*Subscriber
+	SubscribedAt   string                  `json:"subscribed_at,omitempty"`
+	UnsubscribedAt string                  `json:"unsubscribed_at,omitempty"`
+	CreatedAt      string                  `json:"created_at,omitempty"`
+	UpdatedAt      string                  `json:"updated_at,omitempty"`
+	Groups         []createSubscriberGroup `json:"groups,omitempty"`
+	OptedInAt      string                  `json:"opted_in_at,omitempty"`
+}
+
+// createSubscriberGroup is a group of a createSubscriberBody.
+type createSubscriberGroup struct {
+	*Group
+	CreatedAt string `json:"created_at"`
+}
+
+func newCreateSubscriberBody(subscriber *Subscriber) interface{} {
+	if subscriber == nil {
+		return nil
+	}
+
+	body := &createSubscriberBody{
+		Subscriber:     subscriber,
+		SubscribedAt:   subscriber.SubscribedAt.String(),
+		UnsubscribedAt: subscriber.UnsubscribedAt.String(),
+		CreatedAt:      subscriber.CreatedAt.String(),
+		UpdatedAt:      subscriber.UpdatedAt.String(),
+		OptedInAt:      subscriber.OptedInAt.String(),
+	}
+	for i := range subscriber.Groups {
+		group := &subscriber.Groups[i]
+		body.Groups = append(body.Groups, createSubscriberGroup{Group: group, CreatedAt: group.CreatedAt.String()})
+	}
+	return body
+}
+
 type UpdateSubscriber UpsertSubscriber
 
 type UpsertSubscriber struct {
 	ID             string                 `json:"id,omitempty"`
 	Email          string                 `json:"email,omitempty"`
-	Status         string                 `json:"status,omitempty"`
+	Status         SubscriberStatus       `json:"status,omitempty"`
 	IPAddress      interface{}            `json:"ip_address,omitempty"`
 	SubscribedAt   string                 `json:"subscribed_at,omitempty"`
 	UnsubscribedAt interface{}            `json:"unsubscribed_at,omitempty"`
@@ -149,11 +197,11 @@ type Import struct {
 	BannedImportEmailsCount int           `json:"banned_import_emails_count"`
 	MatchRoute              string        `json:"match_route"`
 	SourceLabel             string        `json:"source_label"`
-	UpdatedAt               string        `json:"updated_at"`
-	UndoneAt                interface{}   `json:"undone_at"`
-	StoppedAt               interface{}   `json:"stopped_at"`
-	UndoStartedAt           interface{}   `json:"undo_started_at"`
-	FinishedAt              string        `json:"finished_at"`
+	UpdatedAt               Timestamp     `json:"updated_at"`
+	UndoneAt                Timestamp     `json:"undone_at"`
+	StoppedAt               Timestamp     `json:"stopped_at"`
+	UndoStartedAt           Timestamp     `json:"undo_started_at"`
+	FinishedAt              Timestamp     `json:"finished_at"`
 }
 
 func (s *subscriberService) List(ctx context.Context, options *ListSubscriberOptions) (*RootSubscribers, *Response, error) {
@@ -171,6 +219,23 @@ func (s *subscriberService) List(ctx context.Context, options *ListSubscriberOpt
 	return root, res, nil
 }
 
+// All - returns a Pager over all the subscribers matching the filters of options, following
+// the next cursor of each page and starting at options.Cursor
+func (s *subscriberService) All(ctx context.Context, options *ListSubscriberOptions) *Pager[Subscriber] {
+	opts := ListSubscriberOptions{}
+	if options != nil {
+		opts = *options
+	}
+
+	return newCursorPager(ctx, &opts.Cursor, func(ctx context.Context) ([]Subscriber, *Meta, *Response, error) {
+		root, res, err := s.List(ctx, &opts)
+		if err != nil {
+			return nil, nil, res, err
+		}
+		return root.Data, &root.Meta, res, nil
+	})
+}
+
 // Count - get a count of subscribers
 func (s *subscriberService) Count(ctx context.Context) (*Count, *Response, error) {
 	path := fmt.Sprintf("%s?limit=0", subscriberEndpoint)
@@ -211,13 +276,13 @@ func (s *subscriberService) Get(ctx context.Context, options *GetSubscriberOptio
 
 // Deprecated: use Upsert instead (https://github.com/mailerlite/mailerlite-go/issues/17)
 func (s *subscriberService) Create(ctx context.Context, subscriber *Subscriber) (*RootSubscriber, *Response, error) {
-	req, err := s.client.newRequest(http.MethodPost, subscriberEndpoint, subscriber)
+	req, err := s.client.newRequest(http.MethodPost, subscriberEndpoint, newCreateSubscriberBody(subscriber))
 	if err != nil {
 		return nil, nil, err
 	}
 
 	root := new(RootSubscriber)
-	res, err := s.client.do(ctx, req, root)
+	res, err := s.client.do(withUpsert(ctx), req, root)
 	if err != nil {
 		return nil, res, err
 	}
@@ -226,13 +291,19 @@ func (s *subscriberService) Create(ctx context.Context, subscriber *Subscriber)
 }
 
 func (s *subscriberService) Upsert(ctx context.Context, subscriber *UpsertSubscriber) (*RootSubscriber, *Response, error) {
+	if subscriber != nil {
+		if err := checkStatus(subscriber.Status); err != nil {
+			return nil, nil, err
+		}
+	}
+
 	req, err := s.client.newRequest(http.MethodPost, subscriberEndpoint, subscriber)
 	if err != nil {
 		return nil, nil, err
 	}
 
 	root := new(RootSubscriber)
-	res, err := s.client.do(ctx, req, root)
+	res, err := s.client.do(withUpsert(ctx), req, root)
 	if err != nil {
 		return nil, res, err
 	}
@@ -241,6 +312,10 @@ func (s *subscriberService) Upsert(ctx context.Context, subscriber *UpsertSubscr
 }
 
 func (s *subscriberService) Update(ctx context.Context, subscriber *UpdateSubscriber) (*RootSubscriber, *Response, error) {
+	if err := checkStatus(subscriber.Status); err != nil {
+		return nil, nil, err
+	}
+
 	path := fmt.Sprintf("%s/%s", subscriberEndpoint, subscriber.ID)
 
 	req, err := s.client.newRequest(http.MethodPut, path, subscriber)
@@ -307,6 +382,25 @@ func (s *subscriberService) ActivityLog(ctx context.Context, options *ListActivi
 	return root, res, nil
 }
 
+// AllActivity - returns a Pager over all the activity of a subscriber, starting at options.Page
+func (s *subscriberService) AllActivity(ctx context.Context, options *ListActivityOptions) *Pager[ActivityEntry] {
+	opts := ListActivityOptions{}
+	if options != nil {
+		opts = *options
+	}
+
+	return newPagePager(ctx, opts.Page, func(ctx context.Context, page int) ([]ActivityEntry, *Links, *Meta, *Response, error) {
+		pageOpts := opts
+		pageOpts.Page = page
+
+		root, res, err := s.ActivityLog(ctx, &pageOpts)
+		if err != nil {
+			return nil, nil, nil, res, err
+		}
+		return root.Data, &root.Links, &root.Meta, res, nil
+	})
+}
+
 func (s *subscriberService) GetImport(ctx context.Context, importID string) (*RootImport, *Response, error) {
 	path := fmt.Sprintf("%s/import/%s", subscriberEndpoint, importID)
 
@@ -0,0 +1,293 @@
+package mailerlite
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sort"
+	"strings"
+)
+
+// SyncAction is a change of the membership of a group.
+type SyncAction string
+
+const (
+	SyncAdd    SyncAction = "add"
+	SyncRemove SyncAction = "remove"
+)
+
+// SyncMember is a subscriber to add to or remove from a group. SubscriberID
+// is empty for subscribers to add which were not looked up yet.
+type SyncMember struct {
+	Email        string
+	SubscriberID string
+}
+
+// SyncPlan is the diff between the desired and the actual members of a group.
+type SyncPlan struct {
+	Add       []SyncMember // Add the desired subscribers which are not members, sorted by email.
+	Remove    []SyncMember // Remove the members which are not desired, sorted by email.
+	Unchanged int          // Unchanged is the number of desired subscribers which are members already.
+}
+
+// SyncFailure is a change of a sync which failed.
+type SyncFailure struct {
+	Action SyncAction
+	Member SyncMember
+	Err    error
+}
+
+// SyncReport is the result of GroupService.SyncMembers.
+type SyncReport struct {
+	Plan      SyncPlan
+	DryRun    bool          // DryRun tells that the plan was not applied.
+	Added     int           // Added is the number of subscribers added to the group.
+	Created   int           // Created is the number of added subscribers which did not exist.
+	Removed   int           // Removed is the number of subscribers removed from the group.
+	Unchanged int           // Unchanged is the number of desired subscribers which were members already.
+	Failures  []SyncFailure // Failures in the order of the plan.
+}
+
+// SyncOption configures GroupService.SyncMembers. Every BulkOption is a
+// SyncOption.
+type SyncOption interface {
+	applySync(o *syncOptions)
+}
+
+type syncOptions struct {
+	bulkOptions
+	dryRun bool
+}
+
+func (opt BulkOption) applySync(o *syncOptions) {
+	opt(&o.bulkOptions)
+}
+
+type dryRunOption struct{}
+
+func (dryRunOption) applySync(o *syncOptions) {
+	o.dryRun = true
+}
+
+// WithDryRun makes GroupService.SyncMembers only compute the plan, without
+// changing anything.
+func WithDryRun() SyncOption {
+	return dryRunOption{}
+}
+
+func newSyncOptions(opts []SyncOption) *syncOptions {
+	o := &syncOptions{bulkOptions: *newBulkOptions(nil)}
+	for _, opt := range opts {
+		opt.applySync(o)
+	}
+	if o.concurrency < 1 {
+		o.concurrency = 1
+	}
+	return o
+}
+
+// SyncMembers - makes the subscribers with the desired emails the only members of the group. It lists the
+// members of the group, adds the missing subscribers, creating the ones which do not exist, and removes the
+// others. Emails are compared case insensitively. Changes run concurrently, see WithConcurrency, and a failed
+// change does not stop the others, the report lists the failures. With WithBatching, the subscribers are added
+// and removed through the batch endpoint, like with AssignMany. The error is set when the members can not be
+// listed, or when ctx is done before all the changes were made.
+func (s *groupService) SyncMembers(ctx context.Context, groupID string, desiredEmails []string, opts ...SyncOption) (*SyncReport, error) {
+	o := newSyncOptions(opts)
+
+	plan, err := s.syncPlan(ctx, groupID, desiredEmails, o)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &SyncReport{Plan: *plan, DryRun: o.dryRun, Unchanged: plan.Unchanged}
+	if o.dryRun {
+		return report, nil
+	}
+
+	changes := make([]syncChange, 0, len(plan.Add)+len(plan.Remove))
+	for _, member := range plan.Add {
+		changes = append(changes, syncChange{SyncAdd, member})
+	}
+	for _, member := range plan.Remove {
+		changes = append(changes, syncChange{SyncRemove, member})
+	}
+
+	outcomes := make([]syncOutcome, len(changes))
+	if o.batch {
+		s.syncBatched(ctx, groupID, changes, &o.bulkOptions, outcomes)
+	} else {
+		forEach(ctx, len(changes), o.concurrency, func(i int) {
+			c := changes[i]
+			if c.action == SyncAdd {
+				outcomes[i].created, outcomes[i].err = s.syncAdd(ctx, groupID, c.member.Email)
+			} else {
+				_, outcomes[i].err = s.UnAssign(ctx, groupID, c.member.SubscriberID)
+			}
+			outcomes[i].done = true
+		})
+	}
+
+	done := 0
+	for i, c := range changes {
+		out := outcomes[i]
+		if !out.done {
+			continue
+		}
+		done++
+
+		switch {
+		case out.err != nil:
+			report.Failures = append(report.Failures, SyncFailure{Action: c.action, Member: c.member, Err: out.err})
+		case c.action == SyncRemove:
+			report.Removed++
+		default:
+			report.Added++
+			if out.created {
+				report.Created++
+			}
+		}
+	}
+
+	if done < len(changes) {
+		return report, ctx.Err()
+	}
+	return report, nil
+}
+
+type syncChange struct {
+	action SyncAction
+	member SyncMember
+}
+
+type syncOutcome struct {
+	done    bool
+	created bool
+	err     error
+}
+
+// syncBatched makes the changes with changeMembership, in batches. The
+// subscribers to add are looked up first, so that batches only hold known
+// subscribers.
+func (s *groupService) syncBatched(ctx context.Context, groupID string, changes []syncChange, o *bulkOptions, outcomes []syncOutcome) {
+	subscriberIDs := make([]string, len(changes))
+	var lookups, removes []int
+	for i, c := range changes {
+		if c.action == SyncAdd {
+			lookups = append(lookups, i)
+		} else {
+			subscriberIDs[i] = c.member.SubscriberID
+			removes = append(removes, i)
+		}
+	}
+
+	forEach(ctx, len(lookups), o.concurrency, func(n int) {
+		i := lookups[n]
+		subscriberID, created, err := s.syncLookup(ctx, groupID, changes[i].member.Email)
+		if err != nil || subscriberID == "" {
+			outcomes[i] = syncOutcome{done: true, created: created, err: err}
+			return
+		}
+		subscriberIDs[i] = subscriberID
+	})
+
+	// Subscribers which were not looked up before ctx was done are left out.
+	var adds []int
+	for _, i := range lookups {
+		if subscriberIDs[i] != "" {
+			adds = append(adds, i)
+		}
+	}
+
+	s.syncMembership(ctx, groupID, adds, subscriberIDs, true, o, outcomes)
+	s.syncMembership(ctx, groupID, removes, subscriberIDs, false, o, outcomes)
+}
+
+// syncMembership assigns or unassigns the subscribers of the changes at the
+// indexes, setting the outcomes of the changes done.
+func (s *groupService) syncMembership(ctx context.Context, groupID string, indexes []int, subscriberIDs []string, assign bool, o *bulkOptions, outcomes []syncOutcome) {
+	if len(indexes) == 0 {
+		return
+	}
+
+	ids := make([]string, len(indexes))
+	for n, i := range indexes {
+		ids[n] = subscriberIDs[i]
+	}
+
+	// The report only holds the changes done, ctx.Err() is checked by
+	// SyncMembers.
+	report, _ := s.changeMembership(ctx, groupID, ids, assign, o)
+	for _, result := range report.Results {
+		outcomes[indexes[result.Index]] = syncOutcome{done: true, err: result.Err}
+	}
+}
+
+// syncPlan lists the members of the group and diffs them with the desired
+// emails.
+func (s *groupService) syncPlan(ctx context.Context, groupID string, desiredEmails []string, o *syncOptions) (*SyncPlan, error) {
+	desired := make(map[string]string, len(desiredEmails))
+	for _, email := range desiredEmails {
+		email = strings.TrimSpace(email)
+		if email != "" {
+			desired[strings.ToLower(email)] = email
+		}
+	}
+
+	plan := &SyncPlan{}
+	pager := s.AllSubscribers(ctx, &ListGroupSubscriberOptions{GroupID: groupID}).Prefetch(o.concurrency)
+	for pager.Next() {
+		member := pager.Item()
+		key := strings.ToLower(member.Email)
+		if _, ok := desired[key]; ok {
+			delete(desired, key)
+			plan.Unchanged++
+			continue
+		}
+		plan.Remove = append(plan.Remove, SyncMember{Email: member.Email, SubscriberID: member.ID})
+	}
+	if err := pager.Err(); err != nil {
+		return nil, err
+	}
+
+	for _, email := range desired {
+		plan.Add = append(plan.Add, SyncMember{Email: email})
+	}
+	sortSyncMembers(plan.Add)
+	sortSyncMembers(plan.Remove)
+
+	return plan, nil
+}
+
+func sortSyncMembers(members []SyncMember) {
+	sort.Slice(members, func(i, j int) bool {
+		return strings.ToLower(members[i].Email) < strings.ToLower(members[j].Email)
+	})
+}
+
+// syncAdd adds the subscriber with the email to the group, creating it if it
+// does not exist.
+func (s *groupService) syncAdd(ctx context.Context, groupID, email string) (created bool, err error) {
+	subscriberID, created, err := s.syncLookup(ctx, groupID, email)
+	if err != nil || subscriberID == "" {
+		return created, err
+	}
+
+	_, _, err = s.Assign(ctx, groupID, subscriberID)
+	return false, err
+}
+
+// syncLookup returns the ID of the subscriber with the email. A subscriber
+// which is not found is upserted in the group instead, the ID then being
+// empty and created telling whether it did not exist.
+func (s *groupService) syncLookup(ctx context.Context, groupID, email string) (subscriberID string, created bool, err error) {
+	subscriber, _, err := s.client.Subscriber.Get(ctx, &GetSubscriberOptions{Email: email})
+	if errors.Is(err, ErrNotFound) {
+		_, res, err := s.client.Subscriber.Upsert(ctx, &UpsertSubscriber{Email: email, Groups: []string{groupID}})
+		return "", err == nil && res.StatusCode == http.StatusCreated, err
+	}
+	if err != nil {
+		return "", false, err
+	}
+	return subscriber.Data.ID, false, nil
+}
@@ -0,0 +1,151 @@
+package mailerlite_test
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/mailerlite/mailerlite-go"
+	"github.com/mailerlite/mailerlite-go/mailerlitetest"
+	"github.com/stretchr/testify/assert"
+)
+
+func syncServer(t *testing.T) (*mailerlitetest.Server, *mailerlite.Client, string, map[string]string) {
+	server := mailerlitetest.NewServer()
+	client := server.Client()
+
+	group, _, err := client.Group.Create(context.TODO(), "Customers")
+	assert.NoError(t, err)
+
+	ids := make(map[string]string)
+	for _, email := range []string{"a@example.com", "b@example.com", "c@example.com", "d@example.com"} {
+		upsert := &mailerlite.UpsertSubscriber{Email: email}
+		if email != "d@example.com" {
+			upsert.Groups = []string{group.Data.ID}
+		}
+		root, _, err := client.Subscriber.Upsert(context.TODO(), upsert)
+		assert.NoError(t, err)
+		ids[email] = root.Data.ID
+	}
+	return server, client, group.Data.ID, ids
+}
+
+func groupMembers(t *testing.T, client *mailerlite.Client, groupID string) []string {
+	var emails []string
+	pager := client.Group.AllSubscribers(context.TODO(), &mailerlite.ListGroupSubscriberOptions{GroupID: groupID})
+	for pager.Next() {
+		emails = append(emails, pager.Item().Email)
+	}
+	assert.NoError(t, pager.Err())
+	sort.Strings(emails)
+	return emails
+}
+
+var desiredMembers = []string{"B@example.com", "c@example.com", "d@example.com", "e@example.com", " "}
+
+func TestSyncMembers(t *testing.T) {
+	server, client, groupID, ids := syncServer(t)
+	defer server.Close()
+
+	report, err := client.Group.SyncMembers(context.TODO(), groupID, desiredMembers, mailerlite.WithConcurrency(2))
+
+	assert.NoError(t, err)
+	assert.False(t, report.DryRun)
+	assert.Equal(t, []mailerlite.SyncMember{{Email: "d@example.com"}, {Email: "e@example.com"}}, report.Plan.Add)
+	assert.Equal(t, []mailerlite.SyncMember{{Email: "a@example.com", SubscriberID: ids["a@example.com"]}}, report.Plan.Remove)
+	assert.Equal(t, 2, report.Added)
+	assert.Equal(t, 1, report.Created)
+	assert.Equal(t, 1, report.Removed)
+	assert.Equal(t, 2, report.Unchanged)
+	assert.Empty(t, report.Failures)
+
+	assert.Equal(t, []string{"b@example.com", "c@example.com", "d@example.com", "e@example.com"}, groupMembers(t, client, groupID))
+
+	report, err = client.Group.SyncMembers(context.TODO(), groupID, desiredMembers)
+	assert.NoError(t, err)
+	assert.Empty(t, report.Plan.Add)
+	assert.Empty(t, report.Plan.Remove)
+	assert.Equal(t, 4, report.Unchanged)
+}
+
+func TestSyncMembersBatching(t *testing.T) {
+	server, client, groupID, _ := syncServer(t)
+	defer server.Close()
+
+	requests := server.Requests()
+	report, err := client.Group.SyncMembers(context.TODO(), groupID, []string{"d@example.com", "e@example.com"}, mailerlite.WithBatching())
+
+	assert.NoError(t, err)
+	assert.Equal(t, 2, report.Added)
+	assert.Equal(t, 1, report.Created)
+	assert.Equal(t, 3, report.Removed)
+	assert.Empty(t, report.Failures)
+
+	// The members are listed, d and e looked up, e created, then d added
+	// and a, b and c removed by a batch each.
+	assert.Equal(t, 6, server.Requests()-requests)
+	assert.Equal(t, []string{"d@example.com", "e@example.com"}, groupMembers(t, client, groupID))
+}
+
+func TestSyncMembersDryRun(t *testing.T) {
+	server, client, groupID, _ := syncServer(t)
+	defer server.Close()
+
+	report, err := client.Group.SyncMembers(context.TODO(), groupID, desiredMembers, mailerlite.WithDryRun())
+
+	assert.NoError(t, err)
+	assert.True(t, report.DryRun)
+	assert.Len(t, report.Plan.Add, 2)
+	assert.Len(t, report.Plan.Remove, 1)
+	assert.Zero(t, report.Added+report.Removed)
+
+	assert.Equal(t, []string{"a@example.com", "b@example.com", "c@example.com"}, groupMembers(t, client, groupID))
+	assert.Len(t, server.Subscribers(), 4)
+}
+
+func TestSyncMembersFailure(t *testing.T) {
+	server, client, groupID, ids := syncServer(t)
+	defer server.Close()
+
+	server.InjectFailure(mailerlitetest.Failure{Method: http.MethodDelete, Path: "/subscribers/" + ids["a@example.com"], Status: http.StatusNotFound})
+
+	report, err := client.Group.SyncMembers(context.TODO(), groupID, desiredMembers)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 2, report.Added)
+	assert.Equal(t, 0, report.Removed)
+	assert.Len(t, report.Failures, 1)
+	assert.Equal(t, mailerlite.SyncRemove, report.Failures[0].Action)
+	assert.ErrorIs(t, report.Failures[0].Err, mailerlite.ErrNotFound)
+}
+
+func TestSyncMembersStopsWhenContextIsDone(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.TODO())
+	defer cancel()
+
+	// The group has 50 members which are all removed, the context is
+	// cancelled by the first removal.
+	var members []string
+	for i := 0; i < 50; i++ {
+		members = append(members, fmt.Sprintf(`{"id": "%[1]d", "email": "member-%[1]d@example.com"}`, i))
+	}
+
+	client := mailerlite.NewClient(testKey, mailerlite.WithHTTPClient(NewTestClient(func(req *http.Request) *http.Response {
+		if req.Method == http.MethodDelete {
+			cancel()
+			return &http.Response{StatusCode: http.StatusNoContent, Request: req, Body: io.NopCloser(strings.NewReader(""))}
+		}
+		body := `{"data": [` + strings.Join(members, ",") + `], "meta": {"current_page": 1, "last_page": 1}}`
+		return &http.Response{StatusCode: http.StatusOK, Request: req, Body: io.NopCloser(strings.NewReader(body))}
+	})))
+
+	report, err := client.Group.SyncMembers(ctx, "1", nil, mailerlite.WithConcurrency(1))
+
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.Len(t, report.Plan.Remove, 50)
+	assert.Equal(t, 1, report.Removed+len(report.Failures))
+}
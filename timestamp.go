@@ -0,0 +1,104 @@
+package mailerlite
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// TimestampLayout is the layout of the timestamps of the API, in UTC.
+const TimestampLayout = "2006-01-02 15:04:05"
+
+// timestampLayouts are the layouts accepted when parsing a timestamp.
+var timestampLayouts = []string{TimestampLayout, time.RFC3339Nano, FieldDateLayout}
+
+// Timestamp is a time returned by the API. Null and empty values decode to
+// the zero Timestamp, which encodes back to null.
+//
+// It embeds time.Time, so it can be used for date arithmetic directly:
+//
+//	age := time.Since(subscriber.SubscribedAt.Time)
+//	if subscriber.UnsubscribedAt.IsZero() {
+//		...
+//	}
+//
+// String returns the timestamp as the API formats it, which is what these
+// fields held when they were strings.
+type Timestamp struct {
+	time.Time
+}
+
+// NewTimestamp returns the timestamp of t.
+func NewTimestamp(t time.Time) Timestamp {
+	return Timestamp{Time: t}
+}
+
+// ParseTimestamp parses a timestamp in TimestampLayout, RFC 3339 or
+// FieldDateLayout. Times without a time zone are in UTC. An empty string is
+// the zero Timestamp.
+func ParseTimestamp(s string) (Timestamp, error) {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return Timestamp{}, nil
+	}
+
+	for _, layout := range timestampLayouts {
+		if t, err := time.Parse(layout, s); err == nil {
+			return Timestamp{Time: t}, nil
+		}
+	}
+	return Timestamp{}, fmt.Errorf("mailerlite: invalid timestamp %q", s)
+}
+
+// String returns the timestamp in TimestampLayout, or an empty string for the
+// zero Timestamp.
+func (t Timestamp) String() string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.UTC().Format(TimestampLayout)
+}
+
+// MarshalJSON encodes the timestamp in TimestampLayout, or as null for the
+// zero Timestamp.
+func (t Timestamp) MarshalJSON() ([]byte, error) {
+	if t.IsZero() {
+		return []byte("null"), nil
+	}
+	return []byte(`"` + t.String() + `"`), nil
+}
+
+// UnmarshalJSON decodes a timestamp parsed by ParseTimestamp, or null.
+func (t *Timestamp) UnmarshalJSON(data []byte) error {
+	data = bytes.TrimSpace(data)
+	if bytes.Equal(data, []byte("null")) || bytes.Equal(data, []byte("false")) {
+		*t = Timestamp{}
+		return nil
+	}
+	if len(data) < 2 || data[0] != '"' || data[len(data)-1] != '"' {
+		return fmt.Errorf("mailerlite: invalid timestamp %s", data)
+	}
+
+	parsed, err := ParseTimestamp(string(data[1 : len(data)-1]))
+	if err != nil {
+		return err
+	}
+	*t = parsed
+	return nil
+}
+
+// MarshalText encodes the timestamp as String does.
+func (t Timestamp) MarshalText() ([]byte, error) {
+	return []byte(t.String()), nil
+}
+
+// UnmarshalText decodes a timestamp parsed by ParseTimestamp.
+func (t *Timestamp) UnmarshalText(data []byte) error {
+	parsed, err := ParseTimestamp(string(data))
+	if err != nil {
+		return err
+	}
+	*t = parsed
+	return nil
+}
@@ -0,0 +1,58 @@
+package mailerlite_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/mailerlite/mailerlite-go"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTimestampJSON(t *testing.T) {
+	var subscriber mailerlite.Subscriber
+	err := json.Unmarshal([]byte(`{
+		"subscribed_at": "2024-05-01 10:30:00",
+		"unsubscribed_at": null,
+		"created_at": "2024-05-01T10:30:00.000000Z",
+		"updated_at": "",
+		"opted_in_at": "2024-05-01"
+	}`), &subscriber)
+	assert.NoError(t, err)
+
+	assert.Equal(t, time.Date(2024, 5, 1, 10, 30, 0, 0, time.UTC), subscriber.SubscribedAt.Time)
+	assert.True(t, subscriber.UnsubscribedAt.IsZero())
+	assert.True(t, subscriber.CreatedAt.Equal(subscriber.SubscribedAt.Time))
+	assert.True(t, subscriber.UpdatedAt.IsZero())
+	assert.Equal(t, 10*time.Hour+30*time.Minute, subscriber.SubscribedAt.Sub(subscriber.OptedInAt.Time))
+
+	assert.Equal(t, "2024-05-01 10:30:00", subscriber.SubscribedAt.String())
+	assert.Equal(t, "", subscriber.UnsubscribedAt.String())
+
+	b, err := json.Marshal(struct {
+		Set   mailerlite.Timestamp `json:"set"`
+		Unset mailerlite.Timestamp `json:"unset"`
+	}{Set: subscriber.SubscribedAt})
+	assert.NoError(t, err)
+	assert.Equal(t, `{"set":"2024-05-01 10:30:00","unset":null}`, string(b))
+
+	assert.Error(t, json.Unmarshal([]byte(`{"subscribed_at": "yesterday"}`), &subscriber))
+	assert.Error(t, json.Unmarshal([]byte(`{"subscribed_at": 1714559400}`), &subscriber))
+}
+
+func TestParseTimestamp(t *testing.T) {
+	ts, err := mailerlite.ParseTimestamp("2024-05-01 12:30:00")
+	assert.NoError(t, err)
+	assert.Equal(t, mailerlite.NewTimestamp(time.Date(2024, 5, 1, 12, 30, 0, 0, time.UTC)), ts)
+
+	ts, err = mailerlite.ParseTimestamp("2024-05-01T12:30:00+02:00")
+	assert.NoError(t, err)
+	assert.Equal(t, "2024-05-01 10:30:00", ts.String())
+
+	ts, err = mailerlite.ParseTimestamp("")
+	assert.NoError(t, err)
+	assert.True(t, ts.IsZero())
+
+	_, err = mailerlite.ParseTimestamp("01/05/2024")
+	assert.Error(t, err)
+}
@@ -11,6 +11,7 @@ const webhookEndpoint = "/webhooks"
 // WebhookService defines an interface for webhook-related operations.
 type WebhookService interface {
 	List(ctx context.Context, options *ListWebhookOptions) (*RootWebhooks, *Response, error)
+	All(ctx context.Context, options *ListWebhookOptions) *Pager[Webhook]
 	Get(ctx context.Context, webhookID string) (*RootWebhook, *Response, error)
 	Create(ctx context.Context, webhook *CreateWebhookOptions) (*RootWebhook, *Response, error)
 	Update(ctx context.Context, webhook *UpdateWebhookOptions) (*RootWebhook, *Response, error)
@@ -33,14 +34,14 @@ type RootWebhooks struct {
 }
 
 type Webhook struct {
-	Id        string   `json:"id"`
-	Name      string   `json:"name"`
-	Url       string   `json:"url"`
-	Events    []string `json:"events"`
-	Enabled   bool     `json:"enabled"`
-	Secret    string   `json:"secret"`
-	CreatedAt string   `json:"created_at"`
-	UpdatedAt string   `json:"updated_at"`
+	Id        string    `json:"id"`
+	Name      string    `json:"name"`
+	Url       string    `json:"url"`
+	Events    []string  `json:"events"`
+	Enabled   bool      `json:"enabled"`
+	Secret    string    `json:"secret"`
+	CreatedAt Timestamp `json:"created_at"`
+	UpdatedAt Timestamp `json:"updated_at"`
 }
 
 // ListWebhookOptions - modifies the behavior of WebhookService.List method
@@ -81,6 +82,25 @@ func (s *webhookService) List(ctx context.Context, options *ListWebhookOptions)
 	return root, res, nil
 }
 
+// All - returns a Pager over all the webhooks, starting at options.Page
+func (s *webhookService) All(ctx context.Context, options *ListWebhookOptions) *Pager[Webhook] {
+	opts := ListWebhookOptions{}
+	if options != nil {
+		opts = *options
+	}
+
+	return newPagePager(ctx, opts.Page, func(ctx context.Context, page int) ([]Webhook, *Links, *Meta, *Response, error) {
+		pageOpts := opts
+		pageOpts.Page = page
+
+		root, res, err := s.List(ctx, &pageOpts)
+		if err != nil {
+			return nil, nil, nil, res, err
+		}
+		return root.Data, &root.Links, &root.Meta, res, nil
+	})
+}
+
 func (s *webhookService) Get(ctx context.Context, webhookID string) (*RootWebhook, *Response, error) {
 	path := fmt.Sprintf("%s/%s", webhookEndpoint, webhookID)
 	req, err := s.client.newRequest(http.MethodGet, path, nil)